@@ -0,0 +1,61 @@
+package otf_api
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	AttendanceStatusCheckedIn    = "Checked In"
+	AttendanceStatusNoShow       = "No Show"
+	AttendanceStatusLateCanceled = "Late Cancelled"
+)
+
+type ClassAttendance struct {
+	ClassHistoryUUID string    `json:"classHistoryUUId"`
+	ClassUUID        string    `json:"classUUId"`
+	ClassName        string    `json:"className"`
+	StartDateTime    time.Time `json:"startDateTime"`
+	Status           string    `json:"status"`
+	CheckedIn        bool      `json:"isCheckedIn"`
+	Studio           Studio    `json:"studio"`
+	Coach            Coach     `json:"coach"`
+}
+
+type ClassAttendanceResponse struct {
+	Data []ClassAttendance `json:"data"`
+}
+
+// GetClassAttendance returns the classes the member attended (or missed)
+// between start and end, with check-in status, studio and coach. Unlike
+// GetBookings it covers past classes.
+func (c *Client) GetClassAttendance(
+	ctx context.Context,
+	start time.Time,
+	end time.Time,
+) ([]ClassAttendance, error) {
+	u, err := c.memberURL("class-history")
+	if err != nil {
+		return nil, err
+	}
+
+	params := url.Values{
+		StartDateQueryParamKey: {start.Format(dateLayout)},
+		EndDateQueryParamKey:   {end.Format(dateLayout)},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u+"?"+params.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedResp := ClassAttendanceResponse{}
+	err = c.do(req, &parsedResp)
+	if err != nil {
+		return nil, err
+	}
+
+	return parsedResp.Data, nil
+}
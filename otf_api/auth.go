@@ -5,7 +5,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
+	"time"
 )
 
 type Credentials struct {
@@ -19,79 +22,279 @@ type AuthenticateRequest struct {
 	ClientID       string      `json:"ClientId"`
 }
 
-type IDToken struct {
-	IDToken string `json:"IdToken"`
+type AuthenticationResult struct {
+	IDToken      string `json:"IdToken"`
+	AccessToken  string `json:"AccessToken"`
+	RefreshToken string `json:"RefreshToken"`
+	ExpiresIn    int    `json:"ExpiresIn"`
+	TokenType    string `json:"TokenType"`
 }
 
 type AuthenticateResponse struct {
-	AuthenticationResult IDToken `json:"AuthenticationResult"`
+	AuthenticationResult AuthenticationResult `json:"AuthenticationResult"`
+}
+
+// AuthResult is the outcome of a successful authentication.
+type AuthResult struct {
+	IDToken      string
+	AccessToken  string
+	RefreshToken string
+	ExpiresIn    time.Duration
+	ExpiresAt    time.Time
+	Claims       MemberClaims
 }
 
 // Authenticate sends an authentication request to the OTF API which
 // returns a JWT token when successful. The token will be set on
-// the client instance use in multiple requests.
+// the client instance use in multiple requests. When the client is
+// already authenticated the current session is returned.
 func (c *Client) Authenticate(
 	ctx context.Context,
 	username string,
 	password string,
-) error {
-	if c.NeedAuth() {
-		reqBody := AuthenticateRequest{
-			AuthParameters: Credentials{
-				Username: username,
-				Password: password,
-			},
-			AuthFlow: "USER_PASSWORD_AUTH",
-			ClientID: getEnvVar("OTF_CLIENT_ID"),
-		}
+) (AuthResult, error) {
+	if !c.NeedAuth() {
+		c.authMu.RLock()
+		defer c.authMu.RUnlock()
 
-		jsonBody, err := json.Marshal(reqBody)
-		if err != nil {
-			return fmt.Errorf("failed marshaling request body: %w", err)
-		}
+		return c.session, nil
+	}
 
-		req, err := http.NewRequestWithContext(
-			ctx,
-			http.MethodPost,
-			c.AuthURL,
-			bytes.NewBuffer(jsonBody))
-		if err != nil {
-			return fmt.Errorf("error preparing request: %w", err)
-		}
+	creds := Credentials{
+		Username: username,
+		Password: password,
+	}
+	if c.restoreSession(creds) {
+		c.authMu.RLock()
+		defer c.authMu.RUnlock()
 
-		req.Header = http.Header{
-			"Content-Type": {
-				"application/x-amz-json-1.1",
-			},
-			"X-Amz-Target": {
-				"AWSCognitoIdentityProviderService.InitiateAuth",
-			},
-		}
+		return c.session, nil
+	}
 
-		res, err := c.HTTPClient.Do(req)
-		if err != nil {
-			return fmt.Errorf("error authenticating: %w", err)
-		}
-		defer res.Body.Close()
+	return c.authenticate(ctx, creds)
+}
+
+// authenticate always requests a new token and remembers the credentials
+// so the token can be renewed when it expires.
+func (c *Client) authenticate(ctx context.Context, creds Credentials) (AuthResult, error) {
+	reqBody := AuthenticateRequest{
+		AuthParameters: creds,
+		AuthFlow:       "USER_PASSWORD_AUTH",
+		ClientID:       c.ClientID,
+	}
+
+	jsonBody, err := json.Marshal(reqBody)
+	if err != nil {
+		return AuthResult{}, fmt.Errorf("failed marshaling request body: %w", err)
+	}
 
-		parsedResp := AuthenticateResponse{}
-		err = json.NewDecoder(res.Body).Decode(&parsedResp)
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		c.AuthURL,
+		bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return AuthResult{}, fmt.Errorf("error preparing request: %w", err)
+	}
+
+	req.Header = http.Header{
+		"Content-Type": {
+			"application/x-amz-json-1.1",
+		},
+		"X-Amz-Target": {
+			"AWSCognitoIdentityProviderService.InitiateAuth",
+		},
+	}
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return AuthResult{}, fmt.Errorf("error authenticating: %w", classifyNetworkError(err))
+	}
+	defer res.Body.Close()
+
+	err = checkResponse(res)
+	if err != nil {
+		return AuthResult{}, fmt.Errorf("error authenticating: %w", err)
+	}
+
+	parsedResp := AuthenticateResponse{}
+	err = json.NewDecoder(res.Body).Decode(&parsedResp)
+	if err != nil {
+		return AuthResult{}, fmt.Errorf("error parsing response: %w", err)
+	}
+
+	result := parsedResp.AuthenticationResult
+	claims, err := ParseMemberClaims(result.IDToken)
+	if err != nil {
+		return AuthResult{}, fmt.Errorf("error decoding id token: %w", err)
+	}
+
+	session := AuthResult{
+		IDToken:      result.IDToken,
+		AccessToken:  result.AccessToken,
+		RefreshToken: result.RefreshToken,
+		ExpiresIn:    time.Duration(result.ExpiresIn) * time.Second,
+		ExpiresAt:    time.Now().Add(time.Duration(result.ExpiresIn) * time.Second),
+		Claims:       claims,
+	}
+
+	c.setSession(session, creds)
+
+	if c.tokenCache != nil {
+		err = c.tokenCache.Save(session)
 		if err != nil {
-			return fmt.Errorf("error parsing response: %w", err)
+			// The client is authenticated; only persisting failed.
+			return session, fmt.Errorf("error caching token: %w", err)
 		}
+	}
+
+	return session, nil
+}
 
-		token := parsedResp.AuthenticationResult.IDToken
-		c.HTTPClient.Transport = Chain(
-			nil,
-			AddHeader(http.CanonicalHeaderKey("authorization"), token),
-			AddHeader(http.CanonicalHeaderKey("content-type"), "application/json"),
-		)
+// setSession makes session the client's current session.
+func (c *Client) setSession(session AuthResult, creds Credentials) {
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
+
+	c.Token = session.IDToken
+	c.creds = creds
+	c.session = session
+	if c.MemberID == "" {
+		c.MemberID = session.Claims.MemberUUID
 	}
+}
+
+// rememberMemberID sets the member ID unless one is already known.
+func (c *Client) rememberMemberID(id string) {
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
 
-	return nil
+	if c.MemberID == "" {
+		c.MemberID = id
+	}
 }
 
-// NeedAuth
+// NeedAuth reports whether the client has no token yet.
 func (c *Client) NeedAuth() bool {
-	return c.Token == ""
+	return c.token() == ""
+}
+
+// token returns the current token.
+func (c *Client) token() string {
+	c.authMu.RLock()
+	defer c.authMu.RUnlock()
+
+	return c.Token
+}
+
+// authorize returns a middleware that adds the current token to requests
+// bound for the OTF API hosts. Requests to any other host, including
+// redirect targets, never receive the token.
+func (c *Client) authorize() Middleware {
+	return func(rt http.RoundTripper) http.RoundTripper {
+		return internalRoundTripper(func(req *http.Request) (*http.Response, error) {
+			token := c.token()
+			if token == "" || !c.isAPIHost(req.URL) {
+				return rt.RoundTrip(req)
+			}
+
+			if req.Header == nil {
+				req.Header = make(http.Header)
+			}
+			req.Header.Set("Authorization", token)
+			if req.Header.Get("Content-Type") == "" {
+				req.Header.Set("Content-Type", "application/json")
+			}
+
+			return rt.RoundTrip(req)
+		})
+	}
+}
+
+// isAPIHost reports whether u points at one of the configured OTF API
+// base URLs.
+func (c *Client) isAPIHost(u *url.URL) bool {
+	for _, base := range []string{c.BaseIOURL, c.BaseCOURL, c.BaseTelemetryURL} {
+		b, err := url.Parse(base)
+		if err == nil && b.Scheme == u.Scheme && b.Host == u.Host {
+			return true
+		}
+	}
+
+	return false
+}
+
+// reauthenticate returns a middleware that renews the token once and
+// retries the request when the API rejects it with 401, or with 403 for
+// idempotent reads. A 403 on a write can be a business rule rejection, so
+// writes are never replayed on it. Requests whose body cannot be replayed
+// are not retried.
+func (c *Client) reauthenticate() Middleware {
+	return func(rt http.RoundTripper) http.RoundTripper {
+		return internalRoundTripper(func(req *http.Request) (*http.Response, error) {
+			sentToken := c.token()
+
+			res, err := rt.RoundTrip(req)
+			if err != nil || !c.isAPIHost(req.URL) || sentToken == "" {
+				return res, err
+			}
+			if !shouldReauthenticate(req, res) {
+				return res, err
+			}
+			if req.Body != nil && req.GetBody == nil {
+				return res, err
+			}
+
+			if err := c.renewToken(req.Context(), sentToken); err != nil {
+				return res, nil
+			}
+
+			retry := req.Clone(req.Context())
+			if req.Body != nil {
+				body, err := req.GetBody()
+				if err != nil {
+					return res, nil
+				}
+				retry.Body = body
+			}
+
+			io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+
+			return rt.RoundTrip(retry)
+		})
+	}
+}
+
+// shouldReauthenticate reports whether res rejects req's token.
+func shouldReauthenticate(req *http.Request, res *http.Response) bool {
+	switch res.StatusCode {
+	case http.StatusUnauthorized:
+		return true
+	case http.StatusForbidden:
+		return req.Method == http.MethodGet || req.Method == http.MethodHead || req.Method == http.MethodOptions
+	}
+
+	return false
+}
+
+// renewToken re-runs authentication with the remembered credentials unless
+// another request already replaced the stale token.
+func (c *Client) renewToken(ctx context.Context, staleToken string) error {
+	c.renewMu.Lock()
+	defer c.renewMu.Unlock()
+
+	c.authMu.RLock()
+	token, creds := c.Token, c.creds
+	c.authMu.RUnlock()
+
+	if token != staleToken {
+		return nil
+	}
+	if creds.Username == "" {
+		return ErrNoCredentials
+	}
+
+	_, err := c.authenticate(ctx, creds)
+	return err
 }
@@ -0,0 +1,104 @@
+package otf_api
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrAutoBookGaveUp is returned by AutoBooker.Run when the give-up
+// deadline passes before a spot could be booked.
+var ErrAutoBookGaveUp = errors.New("gave up waiting for an open spot")
+
+// AutoBookResult reports one booking attempt made by an AutoBooker.
+type AutoBookResult struct {
+	ClassID string
+	Booking *Booking
+	Err     error
+	At      time.Time
+}
+
+// AutoBookOption customizes an AutoBooker.
+type AutoBookOption func(*AutoBooker)
+
+// WithGiveUpAt stops an AutoBooker at t if nothing was booked by then.
+func WithGiveUpAt(t time.Time) AutoBookOption {
+	return func(ab *AutoBooker) {
+		ab.giveUpAt = t
+	}
+}
+
+// WithAutoBookCallback calls fn after every booking attempt, successful or
+// not.
+func WithAutoBookCallback(fn func(AutoBookResult)) AutoBookOption {
+	return func(ab *AutoBooker) {
+		ab.onResult = fn
+	}
+}
+
+// AutoBooker books a class watched by a ScheduleWatcher the moment a spot
+// opens.
+type AutoBooker struct {
+	client   *Client
+	watcher  *ScheduleWatcher
+	giveUpAt time.Time
+	onResult func(AutoBookResult)
+}
+
+// NewAutoBooker books the first class of watcher that gets an open spot.
+// Use NewScheduleWatcher for a target class or NewScheduleFilterWatcher
+// for any class matching a filter.
+func (c *Client) NewAutoBooker(watcher *ScheduleWatcher, opts ...AutoBookOption) *AutoBooker {
+	ab := &AutoBooker{
+		client:  c,
+		watcher: watcher,
+	}
+
+	for _, opt := range opts {
+		opt(ab)
+	}
+
+	return ab
+}
+
+// Run watches until a spot is booked and returns the booking. Failed
+// attempts, e.g. because another member took the spot first, are reported
+// to the callback and watching continues. Run returns ErrAutoBookGaveUp
+// once the give-up deadline passes, or ctx's error when it is done.
+func (ab *AutoBooker) Run(ctx context.Context) (*Booking, error) {
+	watchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	if !ab.giveUpAt.IsZero() {
+		var cancelDeadline context.CancelFunc
+		watchCtx, cancelDeadline = context.WithDeadline(watchCtx, ab.giveUpAt)
+		defer cancelDeadline()
+	}
+
+	for ev := range ab.watcher.Watch(watchCtx) {
+		if ev.Type != EventSpotOpened {
+			continue
+		}
+
+		// Book under ctx rather than watchCtx so the give-up deadline
+		// cannot abort a request the server may already have accepted.
+		booking, err := ab.client.BookClass(ctx, CreateBookingRequest{ClassUUID: ev.ClassID})
+		if ab.onResult != nil {
+			ab.onResult(AutoBookResult{
+				ClassID: ev.ClassID,
+				Booking: booking,
+				Err:     err,
+				At:      time.Now(),
+			})
+		}
+		if err == nil {
+			return booking, nil
+		}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return nil, ErrAutoBookGaveUp
+}
@@ -0,0 +1,99 @@
+package otf_api
+
+import (
+	"context"
+	"time"
+)
+
+// expectedDropoutRate is the share of a class's booked members assumed to
+// cancel before class starts, used by WaitlistChance.
+const expectedDropoutRate = 0.1
+
+// SpotsLeft returns the number of spots still open for booking. The API's
+// booking_capacity field holds this remaining count, while max_capacity is
+// the total number of spots in the class.
+func (sc StudioClass) SpotsLeft() int {
+	if sc.Canceled {
+		return 0
+	}
+
+	return max(sc.BookingCapacity, 0)
+}
+
+// BookedCount returns the number of spots already taken.
+func (sc StudioClass) BookedCount() int {
+	return max(sc.MaxCapacity-max(sc.BookingCapacity, 0), 0)
+}
+
+// IsFull reports whether the class has no open spots left.
+func (sc StudioClass) IsFull() bool {
+	return sc.SpotsLeft() == 0
+}
+
+// ShouldWaitlist reports whether joining the waitlist is the way to get
+// into the class: it is full, not canceled and accepts waitlist entries.
+func (sc StudioClass) ShouldWaitlist() bool {
+	return !sc.Canceled && sc.IsFull() && sc.WaitlistAvailable
+}
+
+// WaitlistChance returns a rough likelihood between 0 and 1 that a new
+// waitlist entry clears before class. It assumes about 10% of booked
+// members cancel and compares that with the people already waiting. It
+// is 1 when spots are open and 0 when the waitlist is not an option.
+func (sc StudioClass) WaitlistChance() float64 {
+	if sc.Canceled {
+		return 0
+	}
+	if !sc.IsFull() {
+		return 1
+	}
+	if !sc.WaitlistAvailable {
+		return 0
+	}
+
+	expectedDropouts := float64(sc.MaxCapacity) * expectedDropoutRate
+	position := float64(sc.WaitlistSize + 1)
+
+	return min(expectedDropouts/position, 1)
+}
+
+// ClassAvailability is a point-in-time view of how full a class is.
+type ClassAvailability struct {
+	ClassID           string
+	Capacity          int
+	BookedCount       int
+	SpotsLeft         int
+	WaitlistSize      int
+	WaitlistAvailable bool
+	Canceled          bool
+	FetchedAt         time.Time
+}
+
+// GetClassAvailability returns the current capacity, booked count and
+// waitlist size of a single class, without fetching a whole studio
+// schedule.
+func (c *Client) GetClassAvailability(
+	ctx context.Context,
+	classID string,
+) (ClassAvailability, error) {
+	class, err := c.GetClass(ctx, classID)
+	if err != nil {
+		return ClassAvailability{}, err
+	}
+
+	return class.Availability(), nil
+}
+
+// Availability returns the class's current occupancy.
+func (sc StudioClass) Availability() ClassAvailability {
+	return ClassAvailability{
+		ClassID:           sc.ID,
+		Capacity:          sc.MaxCapacity,
+		BookedCount:       sc.BookedCount(),
+		SpotsLeft:         sc.SpotsLeft(),
+		WaitlistSize:      sc.WaitlistSize,
+		WaitlistAvailable: sc.WaitlistAvailable,
+		Canceled:          sc.Canceled,
+		FetchedAt:         time.Now(),
+	}
+}
@@ -0,0 +1,86 @@
+package otf_api
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+const (
+	EquipmentIDQueryParamKey     = "equipmentId"
+	ChallengeTypeIDQueryParamKey = "challengeTypeId"
+)
+
+// EquipmentType identifies the equipment a benchmark is performed on.
+type EquipmentType int
+
+const (
+	EquipmentTypeTreadmill   EquipmentType = 2
+	EquipmentTypeStrider     EquipmentType = 3
+	EquipmentTypeRower       EquipmentType = 4
+	EquipmentTypeBike        EquipmentType = 5
+	EquipmentTypeWeightFloor EquipmentType = 6
+	EquipmentTypePowerWalker EquipmentType = 7
+)
+
+// ChallengeCategoryBenchmarks covers the recurring fitness benchmarks such
+// as the 12-minute run or the 2000m row.
+const ChallengeCategoryBenchmarks ChallengeCategory = 61
+
+type BenchmarkResult struct {
+	ClassTime  Date    `json:"ClassTime"`
+	StudioName string  `json:"StudioName"`
+	Coach      string  `json:"CoachName"`
+	Result     float64 `json:"TotalResult"`
+	Unit       string  `json:"MetricUnit"`
+	IsPR       bool    `json:"IsPersonalRecord"`
+}
+
+type Benchmark struct {
+	ChallengeID   int               `json:"ChallengeId"`
+	Category      ChallengeCategory `json:"ChallengeCategoryId"`
+	SubCategoryID int               `json:"ChallengeSubCategoryId"`
+	Name          string            `json:"ChallengeName"`
+	Equipment     EquipmentType     `json:"EquipmentId"`
+	BestResult    float64           `json:"BestRecord"`
+	LastResult    float64           `json:"LastRecord"`
+	History       []BenchmarkResult `json:"ChallengeHistories"`
+}
+
+type BenchmarksResponse struct {
+	Dto []Benchmark `json:"Dto"`
+}
+
+// GetBenchmarks returns the member's historical benchmark results. A zero
+// equipmentType or challengeCategory does not filter on that field.
+func (c *Client) GetBenchmarks(
+	ctx context.Context,
+	equipmentType EquipmentType,
+	challengeCategory ChallengeCategory,
+) ([]Benchmark, error) {
+	memberID, err := c.requireMemberID()
+	if err != nil {
+		return nil, err
+	}
+
+	params := url.Values{
+		EquipmentIDQueryParamKey:     {strconv.Itoa(int(equipmentType))},
+		ChallengeTypeIDQueryParamKey: {strconv.Itoa(int(challengeCategory))},
+	}
+
+	u := c.BaseCOURL + "challenges/v3/member/" + url.PathEscape(memberID) +
+		"/benchmarks?" + params.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedResp := BenchmarksResponse{}
+	err = c.do(req, &parsedResp)
+	if err != nil {
+		return nil, err
+	}
+
+	return parsedResp.Dto, nil
+}
@@ -0,0 +1,131 @@
+package otf_api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// RecurringOutcome is the result of booking one occurrence of a recurring
+// class.
+type RecurringOutcome string
+
+const (
+	RecurringBooked      RecurringOutcome = "booked"
+	RecurringWaitlisted  RecurringOutcome = "waitlisted"
+	RecurringUnavailable RecurringOutcome = "unavailable"
+	RecurringFailed      RecurringOutcome = "failed"
+)
+
+// RecurringBookingResult is the outcome for one date.
+type RecurringBookingResult struct {
+	// Date is midnight of the occurrence in studio local time.
+	Date    time.Time
+	Outcome RecurringOutcome
+	// Class is nil when no matching class is scheduled on Date.
+	Class   *StudioClass
+	Booking *Booking
+	Err     error
+}
+
+// RecurringBookingReport collects the results of BookRecurring in date
+// order.
+type RecurringBookingReport struct {
+	Results []RecurringBookingResult
+}
+
+// Count returns the number of results with the outcome.
+func (r RecurringBookingReport) Count(outcome RecurringOutcome) int {
+	n := 0
+	for _, res := range r.Results {
+		if res.Outcome == outcome {
+			n++
+		}
+	}
+
+	return n
+}
+
+// BookRecurring books every occurrence of pattern over the next weeks
+// weeks. Occurrences that cannot be booked are recorded in the report
+// rather than stopping the run; the error is only set when the schedule
+// itself cannot be fetched.
+func (c *Client) BookRecurring(
+	ctx context.Context,
+	pattern RecurringClass,
+	weeks int,
+	opts ...BookOption,
+) (RecurringBookingReport, error) {
+	if weeks < 1 {
+		return RecurringBookingReport{}, fmt.Errorf("weeks must be at least 1")
+	}
+
+	loc := time.UTC
+	if len(pattern.Classes) > 0 {
+		loc = pattern.Classes[0].Studio.Location()
+	}
+
+	now := time.Now().In(loc)
+	from := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	to := from.AddDate(0, 0, 7*weeks)
+
+	schedule, err := c.GetStudiosSchedulesRange(ctx, []string{pattern.StudioID}, now, to)
+	if err != nil {
+		return RecurringBookingReport{}, fmt.Errorf("error fetching schedule: %w", err)
+	}
+
+	byDate := make(map[string]StudioClass)
+	for _, sc := range schedule.Items {
+		if pattern.Matches(sc) {
+			byDate[sc.StartsAtLocal().Format(dateLayout)] = sc
+		}
+	}
+
+	report := RecurringBookingReport{}
+	for day := from; day.Before(to); day = day.AddDate(0, 0, 1) {
+		if !slices.Contains(pattern.Weekdays, day.Weekday()) {
+			continue
+		}
+
+		res := RecurringBookingResult{Date: day, Outcome: RecurringUnavailable}
+		sc, ok := byDate[day.Format(dateLayout)]
+		if !ok || !sc.StartsAt.After(now) {
+			report.Results = append(report.Results, res)
+			continue
+		}
+		res.Class = &sc
+
+		booking, err := c.BookClassByID(ctx, sc.ID, opts...)
+		switch {
+		case errors.Is(err, ErrClassFull), errors.Is(err, ErrClassCanceled):
+			res.Err = err
+		case err != nil:
+			res.Outcome = RecurringFailed
+			res.Err = err
+		case booking.Status == BookingStatusWaitlisted:
+			res.Outcome = RecurringWaitlisted
+			res.Booking = booking
+		default:
+			res.Outcome = RecurringBooked
+			res.Booking = booking
+		}
+		report.Results = append(report.Results, res)
+	}
+
+	return report, nil
+}
+
+// Matches reports whether sc is an occurrence of the pattern: the same
+// class name, studio, studio-local start time and one of its weekdays, and
+// the same coach when the pattern names one.
+func (rc RecurringClass) Matches(sc StudioClass) bool {
+	start := sc.StartsAtLocal()
+
+	return sc.Name == rc.Name &&
+		sc.Studio.ID == rc.StudioID &&
+		start.Hour() == rc.Hour && start.Minute() == rc.Minute &&
+		slices.Contains(rc.Weekdays, start.Weekday()) &&
+		(rc.Coach == "" || sc.Coach.Matches(rc.Coach))
+}
@@ -1,15 +1,231 @@
 package otf_api
 
-type BookingRequest struct {
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	StartDateQueryParamKey = "startDate"
+	EndDateQueryParamKey   = "endDate"
+
+	dateLayout = "2006-01-02"
+)
+
+const (
+	BookingStatusBooked     = "Booked"
+	BookingStatusWaitlisted = "Waitlisted"
+	BookingStatusCancelled  = "Cancelled"
+	BookingStatusCheckedIn  = "Checked In"
+)
+
+// CreateBookingRequest books a class, or joins its waitlist when Waitlist
+// is set.
+type CreateBookingRequest struct {
 	Confirmed bool   `json:"confirmed"`
 	ClassUUID string `json:"classUUId"`
 	Waitlist  bool   `json:"waitlist"`
+	// Guest books the class for a guest of the member.
+	Guest *Guest `json:"guest,omitempty"`
+	// IdempotencyKey identifies the booking attempt so the server can
+	// drop duplicates. One is generated when empty; reuse it when
+	// retrying the same booking.
+	IdempotencyKey string `json:"-"`
+}
+
+// Deprecated: use CreateBookingRequest.
+type BookingRequest = CreateBookingRequest
+
+type Coach struct {
+	CoachUUID string `json:"coachUUId"`
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+}
+
+type BookingClass struct {
+	ClassUUID     string    `json:"classUUId"`
+	Name          string    `json:"name"`
+	StartDateTime time.Time `json:"startDateTime"`
+	EndDateTime   time.Time `json:"endDateTime"`
+	Coach         Coach     `json:"coach"`
+	Studio        Studio    `json:"studio"`
+	IsVirtual     bool      `json:"isVirtual"`
+	// JoinURL is the stream link of a booked OTF Live class.
+	JoinURL string `json:"joinUrl"`
+}
+
+type Booking struct {
+	BookingUUID string       `json:"bookingUUId"`
+	Status      string       `json:"status"`
+	Class       BookingClass `json:"class"`
+	Ratable     bool         `json:"ratable"`
+	// WaitlistPosition is the 1-based position on the waitlist; zero
+	// unless the booking is waitlisted.
+	WaitlistPosition int `json:"waitlistPosition"`
+	// Guest is set for bookings made for a guest of the member.
+	Guest *Guest `json:"guest,omitempty"`
+}
+
+type BookingsResponse struct {
+	Data []Booking `json:"data"`
+}
+
+type BookingResponse struct {
+	Data Booking `json:"data"`
+}
+
+// ErrNotWaitlisted is returned by GetWaitlistPosition for bookings that are
+// not on a waitlist.
+var ErrNotWaitlisted = errors.New("booking is not waitlisted")
+
+var (
+	// ErrClassFull is returned when a class has no open spots and its
+	// waitlist cannot be joined.
+	ErrClassFull = errors.New("class is full")
+	// ErrClassCanceled is returned when booking a canceled class.
+	ErrClassCanceled = errors.New("class is canceled")
+)
+
+// GetBooking returns a single booking, including its waitlist position.
+func (c *Client) GetBooking(ctx context.Context, bookingID string) (Booking, error) {
+	u, err := c.memberURL("bookings", bookingID)
+	if err != nil {
+		return Booking{}, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return Booking{}, err
+	}
+
+	parsedResp := BookingResponse{}
+	err = c.do(req, &parsedResp)
+	if err != nil {
+		return Booking{}, err
+	}
+
+	return parsedResp.Data, nil
+}
+
+// GetWaitlistPosition returns the 1-based waitlist position of a booking.
+func (c *Client) GetWaitlistPosition(ctx context.Context, bookingID string) (int, error) {
+	booking, err := c.GetBooking(ctx, bookingID)
+	if err != nil {
+		return 0, err
+	}
+
+	if booking.Status != BookingStatusWaitlisted {
+		return 0, ErrNotWaitlisted
+	}
+
+	return booking.WaitlistPosition, nil
+}
+
+// GetBookings returns the member's bookings for classes between start and
+// end (inclusive, by date).
+func (c *Client) GetBookings(
+	ctx context.Context,
+	start time.Time,
+	end time.Time,
+) ([]Booking, error) {
+	u, err := c.memberURL("bookings")
+	if err != nil {
+		return nil, err
+	}
+
+	params := url.Values{
+		StartDateQueryParamKey: {start.Format(dateLayout)},
+		EndDateQueryParamKey:   {end.Format(dateLayout)},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u+"?"+params.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedResp := BookingsResponse{}
+	err = c.do(req, &parsedResp)
+	if err != nil {
+		return nil, err
+	}
+
+	return parsedResp.Data, nil
 }
 
-// func (c *Client) BookClass(
-// 	ctx context.Context,
-// 	classID string,
-// 	waitlist bool,
-// ) error {
+// BookClass books a class and returns the created booking, including its
+// status and waitlist position. When the member already holds a booking or
+// waitlist spot for the class, that booking is returned instead, so retried
+// calls cannot double-book.
+func (c *Client) BookClass(
+	ctx context.Context,
+	bookingReq CreateBookingRequest,
+) (*Booking, error) {
+	if err := bookingReq.Validate(); err != nil {
+		return nil, err
+	}
+
+	u, err := c.memberURL("bookings")
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := c.findActiveBooking(ctx, bookingReq)
+	if err != nil {
+		return nil, fmt.Errorf("error checking existing bookings: %w", err)
+	}
+	if existing != nil {
+		return existing, nil
+	}
+
+	if bookingReq.IdempotencyKey == "" {
+		bookingReq.IdempotencyKey = newIdempotencyKey()
+	}
 
-// }
+	bookingReq.Confirmed = true
+	req, err := newJSONRequest(ctx, http.MethodPost, u, bookingReq)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set(IdempotencyKeyHeader, bookingReq.IdempotencyKey)
+
+	if c.dryRun {
+		logDryRun(req, bookingReq)
+		booking := dryRunBooking(bookingReq)
+		c.emitBooked(booking, true)
+		return booking, nil
+	}
+
+	if err := c.writeThrottle.wait(ctx); err != nil {
+		return nil, err
+	}
+
+	parsedResp := BookingResponse{}
+	err = c.do(req, &parsedResp)
+	if err != nil && !isDuplicateBooking(err) {
+		c.writeThrottle.failed()
+	}
+	if isUncertainFailure(err) {
+		// The booking may have been created before the response was lost.
+		if existing, findErr := c.findActiveBooking(ctx, bookingReq); findErr == nil && existing != nil {
+			c.emitBooked(existing, false)
+			return existing, nil
+		}
+	}
+	if isDuplicateBooking(err) {
+		// Another attempt or tool booked the class first; converge on
+		// that booking.
+		if existing := c.duplicateBooking(ctx, err, bookingReq); existing != nil {
+			return existing, nil
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error booking class: %w", asBookingError(err))
+	}
+
+	c.emitBooked(&parsedResp.Data, false)
+	return &parsedResp.Data, nil
+}
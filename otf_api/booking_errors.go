@@ -0,0 +1,107 @@
+package otf_api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Booking failures reported by the API. Errors returned by BookClass match
+// these with errors.Is, e.g. errors.Is(err, otf_api.ErrPenaltyHold).
+// ErrClassFull is also returned.
+var (
+	ErrAlreadyBooked              = errors.New("class is already booked")
+	ErrBookingWindowClosed        = errors.New("booking window is closed")
+	ErrMembershipCreditsExhausted = errors.New("membership credits exhausted")
+	ErrPenaltyHold                = errors.New("account has a penalty hold")
+)
+
+// BookingError is a booking rejected by the API for a known reason.
+type BookingError struct {
+	Kind    error
+	Message string
+	Err     *APIError
+}
+
+func (e *BookingError) Error() string {
+	if e.Message == "" {
+		return e.Kind.Error()
+	}
+
+	return fmt.Sprintf("%v: %s", e.Kind, e.Message)
+}
+
+func (e *BookingError) Unwrap() []error {
+	return []error{e.Kind, e.Err}
+}
+
+// bookingFailure is the error payload of a rejected booking.
+type bookingFailure struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// bookingFailureKinds maps error codes and message fragments, lowercased,
+// to booking failure kinds.
+var bookingFailureKinds = []struct {
+	match []string
+	kind  error
+}{
+	{[]string{"class_full", "class is full", "no spots"}, ErrClassFull},
+	{[]string{"already_booked", "already booked"}, ErrAlreadyBooked},
+	{[]string{"booking_window", "booking window", "not yet open", "too late to book"}, ErrBookingWindowClosed},
+	{[]string{"insufficient_credits", "no credits", "out of credits", "credits exhausted"}, ErrMembershipCreditsExhausted},
+	{[]string{"penalty", "on hold"}, ErrPenaltyHold},
+}
+
+// asBookingError converts an *APIError carrying a known booking failure
+// into a *BookingError. Other errors go through asValidationError.
+func asBookingError(err error) error {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode < 400 || apiErr.StatusCode >= 500 {
+		return err
+	}
+
+	failure := bookingFailure{}
+	_ = json.Unmarshal(apiErr.Body, &failure)
+	if failure.Code == "" && failure.Message == "" {
+		failure.Message = string(apiErr.Body)
+	}
+
+	text := strings.ToLower(failure.Code + " " + failure.Message)
+	for _, k := range bookingFailureKinds {
+		for _, m := range k.match {
+			if strings.Contains(text, m) {
+				return &BookingError{Kind: k.kind, Message: failure.Message, Err: apiErr}
+			}
+		}
+	}
+	if apiErr.StatusCode == http.StatusConflict {
+		return &BookingError{Kind: ErrAlreadyBooked, Message: failure.Message, Err: apiErr}
+	}
+
+	return asValidationError(err)
+}
+
+// IsRetryable reports whether a failed booking may succeed later without
+// the member doing anything, e.g. because a spot opens up or the network
+// recovers. Window, credit, penalty and duplicate failures are not
+// retryable.
+func IsRetryable(err error) bool {
+	switch {
+	case errors.Is(err, ErrClassFull):
+		return true
+	case errors.Is(err, ErrTimeout), errors.Is(err, ErrConnReset),
+		errors.Is(err, ErrConnRefused), errors.Is(err, ErrDNS), errors.Is(err, ErrNetworkOther):
+		return true
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusTooManyRequests || apiErr.StatusCode >= 500
+	}
+
+	return false
+}
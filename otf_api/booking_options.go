@@ -0,0 +1,63 @@
+package otf_api
+
+import (
+	"context"
+	"fmt"
+)
+
+// BookOption customizes BookClassByID.
+type BookOption func(*bookOptions)
+
+type bookOptions struct {
+	waitlist bool
+}
+
+func newBookOptions(opts []BookOption) *bookOptions {
+	o := &bookOptions{
+		waitlist: true,
+	}
+
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return o
+}
+
+// WithWaitlist controls whether BookClassByID joins the waitlist of a full
+// class. It does by default.
+func WithWaitlist(join bool) BookOption {
+	return func(o *bookOptions) {
+		o.waitlist = join
+	}
+}
+
+// BookClassByID books a class by ID, checking its availability first and
+// joining the waitlist when it is full. It returns ErrClassFull when there
+// is no spot and the waitlist cannot be joined, and ErrClassCanceled for
+// canceled classes.
+func (c *Client) BookClassByID(
+	ctx context.Context,
+	classID string,
+	opts ...BookOption,
+) (*Booking, error) {
+	o := newBookOptions(opts)
+
+	avail, err := c.GetClassAvailability(ctx, classID)
+	if err != nil {
+		return nil, fmt.Errorf("error checking class availability: %w", err)
+	}
+
+	bookingReq := CreateBookingRequest{ClassUUID: classID}
+	switch {
+	case avail.Canceled:
+		return nil, ErrClassCanceled
+	case avail.SpotsLeft > 0:
+	case o.waitlist && avail.WaitlistAvailable:
+		bookingReq.Waitlist = true
+	default:
+		return nil, ErrClassFull
+	}
+
+	return c.BookClass(ctx, bookingReq)
+}
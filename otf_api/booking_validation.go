@@ -0,0 +1,39 @@
+package otf_api
+
+import (
+	"net/mail"
+	"strings"
+)
+
+// Validate checks the request before it is sent, returning a
+// *ValidationError listing every invalid field.
+func (r CreateBookingRequest) Validate() error {
+	var fields []FieldError
+
+	switch {
+	case r.ClassUUID == "":
+		fields = append(fields, FieldError{Field: "classUUId", Message: "is required"})
+	case strings.ContainsAny(r.ClassUUID, " \t\n/?#"):
+		fields = append(fields, FieldError{Field: "classUUId", Message: "is not a valid class id"})
+	}
+
+	if r.Guest != nil {
+		if strings.TrimSpace(r.Guest.FirstName) == "" {
+			fields = append(fields, FieldError{Field: "guest.firstName", Message: "is required"})
+		}
+		if strings.TrimSpace(r.Guest.LastName) == "" {
+			fields = append(fields, FieldError{Field: "guest.lastName", Message: "is required"})
+		}
+		if r.Guest.Email == "" {
+			fields = append(fields, FieldError{Field: "guest.email", Message: "is required"})
+		} else if _, err := mail.ParseAddress(r.Guest.Email); err != nil {
+			fields = append(fields, FieldError{Field: "guest.email", Message: "is not a valid email address"})
+		}
+	}
+
+	if len(fields) == 0 {
+		return nil
+	}
+
+	return &ValidationError{Message: "invalid booking request", Fields: fields}
+}
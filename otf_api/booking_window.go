@@ -0,0 +1,79 @@
+package otf_api
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+const (
+	// bookingWindowRetries is how many times WaitForBookingWindow retries
+	// a booking rejected because the window is not open yet, to absorb
+	// clock skew with the server.
+	bookingWindowRetries       = 5
+	bookingWindowRetryInterval = time.Second
+)
+
+// BookingOpen reports whether the class can be booked at t.
+func (sc StudioClass) BookingOpen(t time.Time) bool {
+	if !sc.BookingOpensAt.IsZero() && t.Before(sc.BookingOpensAt) {
+		return false
+	}
+	if !sc.BookingClosesAt.IsZero() && !t.Before(sc.BookingClosesAt) {
+		return false
+	}
+
+	return !sc.Canceled
+}
+
+// WaitForBookingWindow sleeps until booking opens for the class and then
+// books it with BookClassByID, for classes that fill within minutes of
+// opening. It books right away when the window is already open and
+// returns ErrBookingWindowClosed once it has closed.
+func (c *Client) WaitForBookingWindow(
+	ctx context.Context,
+	classID string,
+	opts ...BookOption,
+) (*Booking, error) {
+	class, err := c.GetClass(ctx, classID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !class.BookingClosesAt.IsZero() && !time.Now().Before(class.BookingClosesAt) {
+		return nil, ErrBookingWindowClosed
+	}
+
+	if err := sleepUntil(ctx, class.BookingOpensAt); err != nil {
+		return nil, err
+	}
+
+	for attempt := 0; ; attempt++ {
+		booking, err := c.BookClassByID(ctx, classID, opts...)
+		if !errors.Is(err, ErrBookingWindowClosed) || attempt == bookingWindowRetries {
+			return booking, err
+		}
+
+		if err := sleepUntil(ctx, time.Now().Add(bookingWindowRetryInterval)); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// sleepUntil waits until t or until ctx is done.
+func sleepUntil(ctx context.Context, t time.Time) error {
+	delay := time.Until(t)
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
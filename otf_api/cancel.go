@@ -0,0 +1,171 @@
+package otf_api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// DefaultLateCancelWindow is how long before class start a cancellation
+// counts as late under the standard studio policy. CancelBooking uses the
+// studio's own policy from GetCancellationPolicy.
+const DefaultLateCancelWindow = 8 * time.Hour
+
+// ErrLateCancel is returned when canceling inside the late cancellation
+// window, which can incur a fee or penalty, without AllowLateCancel.
+var ErrLateCancel = errors.New("cancellation is inside the late cancel window")
+
+// CancelOption customizes CancelBooking.
+type CancelOption func(*cancelOptions)
+
+type cancelOptions struct {
+	allowLate bool
+	window    time.Duration
+}
+
+// AllowLateCancel cancels even inside the late cancellation window.
+func AllowLateCancel() CancelOption {
+	return func(o *cancelOptions) {
+		o.allowLate = true
+	}
+}
+
+// WithLateCancelWindow overrides the studio's late cancellation window.
+func WithLateCancelWindow(d time.Duration) CancelOption {
+	return func(o *cancelOptions) {
+		o.window = d
+	}
+}
+
+// checkCancel computes the cancellation deadline of booking, using the
+// studio's policy unless WithLateCancelWindow is passed, and returns
+// ErrLateCancel when it has passed and late cancels are not allowed.
+func (c *Client) checkCancel(
+	ctx context.Context,
+	booking Booking,
+	opts []CancelOption,
+) (CancelResult, error) {
+	o := &cancelOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	policy := defaultCancellationPolicy
+	if o.window == 0 && booking.Status != BookingStatusWaitlisted {
+		var err error
+		policy, err = c.GetCancellationPolicy(ctx, booking.Class.Studio.StudioUUID)
+		if err != nil {
+			return CancelResult{}, fmt.Errorf("error fetching cancellation policy: %w", err)
+		}
+	}
+	if o.window == 0 {
+		o.window = DefaultLateCancelWindow
+		if policy.LateCancelHours > 0 {
+			o.window = policy.Window()
+		}
+	}
+
+	result := CancelResult{
+		BookingID: booking.BookingUUID,
+		Deadline:  booking.Class.StartDateTime.Add(-o.window),
+		Policy:    policy,
+	}
+	if booking.Status != BookingStatusWaitlisted && !time.Now().Before(result.Deadline) {
+		result.LateCancel = true
+		if !o.allowLate {
+			return result, ErrLateCancel
+		}
+	}
+
+	return result, nil
+}
+
+// CancelResult is the outcome of a cancellation.
+type CancelResult struct {
+	BookingID string
+	Status    string
+	// LateCancel reports whether the cancellation was late, either by
+	// the computed window or because the server flagged it.
+	LateCancel bool
+	// Deadline is the last time the booking could be canceled without
+	// penalty.
+	Deadline time.Time
+	// Policy is the studio policy the deadline was computed from.
+	Policy CancellationPolicy
+}
+
+type cancelBookingResponse struct {
+	Data struct {
+		Status     string `json:"status"`
+		LateCancel bool   `json:"isLateCancel"`
+	} `json:"data"`
+}
+
+// CancelBooking cancels a booking or waitlist spot. Cancellations inside
+// the late cancellation window return ErrLateCancel unless AllowLateCancel
+// is passed. Leaving a waitlist is never late.
+func (c *Client) CancelBooking(
+	ctx context.Context,
+	bookingID string,
+	opts ...CancelOption,
+) (CancelResult, error) {
+	booking, err := c.GetBooking(ctx, bookingID)
+	if err != nil {
+		return CancelResult{}, err
+	}
+
+	result, err := c.checkCancel(ctx, booking, opts)
+	if err != nil {
+		return result, err
+	}
+
+	u, err := c.memberURL("bookings", bookingID)
+	if err != nil {
+		return CancelResult{}, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, u, nil)
+	if err != nil {
+		return CancelResult{}, err
+	}
+
+	if c.dryRun {
+		logDryRun(req, nil)
+		result.BookingID = bookingID
+		result.Status = BookingStatusCancelled
+		c.emitCanceled(booking, result, true)
+		return result, nil
+	}
+
+	if err := c.writeThrottle.wait(ctx); err != nil {
+		return CancelResult{}, err
+	}
+
+	parsedResp := cancelBookingResponse{}
+	err = c.doStream(req, func(body io.Reader) error {
+		// The response body may be empty.
+		err := json.NewDecoder(body).Decode(&parsedResp)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		c.writeThrottle.failed()
+		return CancelResult{}, fmt.Errorf("error canceling booking: %w", err)
+	}
+
+	result.BookingID = bookingID
+	result.Status = parsedResp.Data.Status
+	if result.Status == "" {
+		result.Status = BookingStatusCancelled
+	}
+	result.LateCancel = result.LateCancel || parsedResp.Data.LateCancel
+
+	c.emitCanceled(booking, result, false)
+	return result, nil
+}
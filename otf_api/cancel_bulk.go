@@ -0,0 +1,62 @@
+package otf_api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// BulkCancelResult is the outcome of canceling one booking.
+type BulkCancelResult struct {
+	Booking Booking
+	Result  CancelResult
+	// Skipped is set for bookings inside the late cancellation window
+	// when AllowLateCancel was not passed.
+	Skipped bool
+	Err     error
+}
+
+// CancelBookings cancels every upcoming booking and waitlist spot for
+// classes between start and end (inclusive, by date) accepted by filter,
+// e.g. when traveling. A nil filter matches every booking. Bookings inside
+// the late cancellation window are skipped unless AllowLateCancel is
+// passed. Failures are reported per booking; the error is only set when
+// the bookings cannot be listed.
+func (c *Client) CancelBookings(
+	ctx context.Context,
+	start time.Time,
+	end time.Time,
+	filter func(Booking) bool,
+	opts ...CancelOption,
+) ([]BulkCancelResult, error) {
+	bookings, err := c.GetBookings(ctx, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("error listing bookings: %w", err)
+	}
+
+	now := time.Now()
+
+	var results []BulkCancelResult
+	for _, b := range bookings {
+		if b.Status != BookingStatusBooked && b.Status != BookingStatusWaitlisted {
+			continue
+		}
+		if !b.Class.StartDateTime.After(now) {
+			continue
+		}
+		if filter != nil && !filter(b) {
+			continue
+		}
+
+		res, err := c.CancelBooking(ctx, b.BookingUUID, opts...)
+		results = append(results, BulkCancelResult{
+			Booking: b,
+			Result:  res,
+			Skipped: errors.Is(err, ErrLateCancel),
+			Err:     err,
+		})
+	}
+
+	return results, nil
+}
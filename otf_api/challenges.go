@@ -0,0 +1,119 @@
+package otf_api
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+)
+
+// ChallengeCategory identifies an OTF challenge series.
+type ChallengeCategory int
+
+const (
+	ChallengeCategoryOther               ChallengeCategory = 0
+	ChallengeCategoryDriTri              ChallengeCategory = 2
+	ChallengeCategoryMarathonMonth       ChallengeCategory = 5
+	ChallengeCategoryHellWeek            ChallengeCategory = 52
+	ChallengeCategoryAllOutMayhem        ChallengeCategory = 58
+	ChallengeCategoryTwelveDaysOfFitness ChallengeCategory = 63
+)
+
+type ChallengeYear struct {
+	Year           int     `json:"Year"`
+	IsParticipated bool    `json:"IsParticipated"`
+	InProgress     bool    `json:"InProgress"`
+	IsCompleted    bool    `json:"IsCompleted"`
+	StartDate      Date    `json:"StartDate"`
+	EndDate        Date    `json:"EndDate"`
+	Progress       float64 `json:"Progress"`
+	Result         string  `json:"Result"`
+}
+
+type Challenge struct {
+	ChallengeID      int               `json:"ChallengeId"`
+	Category         ChallengeCategory `json:"ChallengeCategoryId"`
+	SubCategoryID    int               `json:"ChallengeSubCategoryId"`
+	Name             string            `json:"ChallengeName"`
+	Years            []ChallengeYear   `json:"Years"`
+	LastParticipated Date              `json:"LastParticipatedDate"`
+}
+
+type ChallengeTracker struct {
+	Programs   []Challenge `json:"Programs"`
+	Challenges []Challenge `json:"Challenges"`
+	Benchmarks []Challenge `json:"Benchmarks"`
+}
+
+type ChallengeTrackerResponse struct {
+	Dto ChallengeTracker `json:"Dto"`
+}
+
+// GetChallengeTracker returns the member's participation in OTF programs,
+// challenges (DriTri, Hell Week, Transformation Challenge, ...) and
+// benchmarks, with per-year progress and results.
+func (c *Client) GetChallengeTracker(ctx context.Context) (ChallengeTracker, error) {
+	memberID, err := c.requireMemberID()
+	if err != nil {
+		return ChallengeTracker{}, err
+	}
+
+	u := c.BaseCOURL + "challenges/v3.1/member/" + url.PathEscape(memberID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return ChallengeTracker{}, err
+	}
+
+	parsedResp := ChallengeTrackerResponse{}
+	err = c.do(req, &parsedResp)
+	if err != nil {
+		return ChallengeTracker{}, err
+	}
+
+	return parsedResp.Dto, nil
+}
+
+type LeaderboardEntry struct {
+	Rank            int     `json:"Rank"`
+	MemberName      string  `json:"MemberName"`
+	Result          float64 `json:"Result"`
+	Unit            string  `json:"MetricUnit"`
+	IsCurrentMember bool    `json:"IsCurrentMember"`
+}
+
+type ChallengeLeaderboardResponse struct {
+	Dto []LeaderboardEntry `json:"Dto"`
+}
+
+// GetChallengeLeaderboard returns the ranked results of a challenge at a
+// studio, best first.
+func (c *Client) GetChallengeLeaderboard(
+	ctx context.Context,
+	challengeID int,
+	studioUUID string,
+) ([]LeaderboardEntry, error) {
+	params := url.Values{
+		StudioUUIDQueryParamKey: {studioUUID},
+	}
+
+	u := c.BaseCOURL + "challenges/v3/" + strconv.Itoa(challengeID) +
+		"/leaderboard?" + params.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedResp := ChallengeLeaderboardResponse{}
+	err = c.do(req, &parsedResp)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := parsedResp.Dto
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Rank < entries[j].Rank
+	})
+
+	return entries, nil
+}
@@ -0,0 +1,140 @@
+package otf_api
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrNotAuthenticated is returned when an operation needs a token but the
+// client has not authenticated yet.
+var ErrNotAuthenticated = errors.New("client is not authenticated")
+
+// MemberClaims are the claims of the Cognito ID token that identify the
+// member.
+type MemberClaims struct {
+	Subject          string
+	Email            string
+	EmailVerified    bool
+	GivenName        string
+	FamilyName       string
+	MemberUUID       string
+	HomeStudioUUID   string
+	ClientID         string
+	Issuer           string
+	IssuedAt         time.Time
+	ExpiresAt        time.Time
+	AdditionalClaims map[string]any
+}
+
+type idTokenClaims struct {
+	Subject         string `json:"sub"`
+	Email           string `json:"email"`
+	EmailVerified   bool   `json:"email_verified"`
+	GivenName       string `json:"given_name"`
+	FamilyName      string `json:"family_name"`
+	CognitoUsername string `json:"cognito:username"`
+	HomeStudioID    string `json:"custom:home_studio_id"`
+	Audience        string `json:"aud"`
+	Issuer          string `json:"iss"`
+	IssuedAt        int64  `json:"iat"`
+	ExpiresAt       int64  `json:"exp"`
+}
+
+// ParseMemberClaims decodes the payload of a Cognito ID token. The token
+// signature is not verified; the claims are only used to learn about the
+// authenticated member.
+func ParseMemberClaims(token string) (MemberClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return MemberClaims{}, fmt.Errorf("malformed token: expected 3 segments, got %d", len(parts))
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return MemberClaims{}, fmt.Errorf("malformed token payload: %w", err)
+	}
+
+	raw := idTokenClaims{}
+	err = json.Unmarshal(payload, &raw)
+	if err != nil {
+		return MemberClaims{}, fmt.Errorf("malformed token claims: %w", err)
+	}
+
+	all := map[string]any{}
+	err = json.Unmarshal(payload, &all)
+	if err != nil {
+		return MemberClaims{}, fmt.Errorf("malformed token claims: %w", err)
+	}
+
+	return MemberClaims{
+		Subject:          raw.Subject,
+		Email:            raw.Email,
+		EmailVerified:    raw.EmailVerified,
+		GivenName:        raw.GivenName,
+		FamilyName:       raw.FamilyName,
+		MemberUUID:       raw.CognitoUsername,
+		HomeStudioUUID:   raw.HomeStudioID,
+		ClientID:         raw.Audience,
+		Issuer:           raw.Issuer,
+		IssuedAt:         time.Unix(raw.IssuedAt, 0),
+		ExpiresAt:        time.Unix(raw.ExpiresAt, 0),
+		AdditionalClaims: all,
+	}, nil
+}
+
+// Claims returns the claims of the client's current ID token, decoding the
+// token on first use.
+func (c *Client) Claims() (MemberClaims, error) {
+	c.authMu.RLock()
+	token, session := c.Token, c.session
+	c.authMu.RUnlock()
+
+	if token == "" {
+		return MemberClaims{}, ErrNotAuthenticated
+	}
+	if session.IDToken == token {
+		return session.Claims, nil
+	}
+
+	claims, err := ParseMemberClaims(token)
+	if err != nil {
+		return MemberClaims{}, err
+	}
+
+	c.authMu.Lock()
+	if c.Token == token {
+		c.session.IDToken = token
+		c.session.Claims = claims
+	}
+	c.authMu.Unlock()
+
+	return claims, nil
+}
+
+// MemberUUID returns the member UUID required by member-scoped endpoints.
+// An explicitly set MemberID takes precedence over the token claims.
+func (c *Client) MemberUUID() (string, error) {
+	c.authMu.RLock()
+	memberID := c.MemberID
+	c.authMu.RUnlock()
+
+	if memberID != "" {
+		return memberID, nil
+	}
+
+	claims, err := c.Claims()
+	if err != nil {
+		return "", err
+	}
+	if claims.MemberUUID == "" {
+		return "", ErrMemberIDRequired
+	}
+
+	c.rememberMemberID(claims.MemberUUID)
+
+	return claims.MemberUUID, nil
+}
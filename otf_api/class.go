@@ -0,0 +1,35 @@
+package otf_api
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// ClassDetail is the full description of a single class.
+type ClassDetail struct {
+	StudioClass
+	Description string   `json:"description"`
+	Equipment   []string `json:"equipment"`
+}
+
+// GetClass returns the full detail of a class by ID, without fetching a
+// whole studio schedule to find it.
+func (c *Client) GetClass(
+	ctx context.Context,
+	classID string,
+) (ClassDetail, error) {
+	u := c.BaseIOURL + "classes/" + url.PathEscape(classID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return ClassDetail{}, err
+	}
+
+	parsedResp := ClassDetail{}
+	err = c.do(req, &parsedResp)
+	if err != nil {
+		return ClassDetail{}, err
+	}
+
+	return parsedResp, nil
+}
@@ -0,0 +1,75 @@
+package otf_api
+
+import "strings"
+
+// WorkoutTemplate is the class format a workout follows.
+type WorkoutTemplate string
+
+const (
+	TemplateUnknown    WorkoutTemplate = ""
+	Template2G         WorkoutTemplate = "2G"
+	Template3G         WorkoutTemplate = "3G"
+	TemplateTread50    WorkoutTemplate = "Tread 50"
+	TemplateStrength50 WorkoutTemplate = "Strength 50"
+)
+
+// Station is a block of equipment members rotate through during a class.
+type Station string
+
+const (
+	StationTread Station = "tread"
+	StationRower Station = "rower"
+	StationFloor Station = "floor"
+)
+
+// templateKeywords maps lower-cased class name fragments to templates.
+// The more specific formats are checked first.
+var templateKeywords = []struct {
+	keyword  string
+	template WorkoutTemplate
+}{
+	{"strength 50", TemplateStrength50},
+	{"strength50", TemplateStrength50},
+	{"tread 50", TemplateTread50},
+	{"tread50", TemplateTread50},
+	{"3g", Template3G},
+	{"2g", Template2G},
+}
+
+// ClassifyWorkout infers the workout template from a class name such as
+// "Orange 60 Min 2G" or "Tread 50". TemplateUnknown is returned when the
+// name carries no recognizable format.
+func ClassifyWorkout(className string) WorkoutTemplate {
+	name := strings.ToLower(className)
+	for _, k := range templateKeywords {
+		if strings.Contains(name, k.keyword) {
+			return k.template
+		}
+	}
+
+	return TemplateUnknown
+}
+
+// Template returns the workout template of the class based on its name.
+func (sc StudioClass) Template() WorkoutTemplate {
+	return ClassifyWorkout(sc.Name)
+}
+
+// Stations returns the stations a member rotates through for the template,
+// in the order a class usually starts them. It returns nil when the
+// rotation cannot be inferred.
+func (t WorkoutTemplate) Stations() []Station {
+	switch t {
+	case Template2G:
+		// The rower and floor share one block in a 2G class.
+		return []Station{StationTread, StationFloor}
+	case Template3G:
+		return []Station{StationTread, StationRower, StationFloor}
+	case TemplateTread50:
+		return []Station{StationTread, StationFloor}
+	case TemplateStrength50:
+		return []Station{StationFloor}
+	default:
+		return nil
+	}
+}
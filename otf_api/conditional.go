@@ -0,0 +1,187 @@
+package otf_api
+
+import (
+	"bytes"
+	"container/list"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Limits of the conditional request store. Entries are evicted least
+// recently used first once either cap is reached, and expire after the
+// TTL. Bodies larger than conditionalMaxBodySize are streamed through
+// without being stored.
+const (
+	conditionalMaxEntries  = 128
+	conditionalMaxBytes    = 8 << 20
+	conditionalMaxBodySize = 1 << 20
+	conditionalTTL         = time.Hour
+)
+
+// validatedResponse is a response body stored with its validators.
+type validatedResponse struct {
+	key          string
+	etag         string
+	lastModified string
+	header       http.Header
+	body         []byte
+	storedAt     time.Time
+}
+
+// conditionalStore is a size-bounded LRU of validated responses.
+type conditionalStore struct {
+	mu      sync.Mutex
+	lru     *list.List
+	entries map[string]*list.Element
+	size    int
+}
+
+func newConditionalStore() *conditionalStore {
+	return &conditionalStore{
+		lru:     list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+func (s *conditionalStore) get(key string) (validatedResponse, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	el, ok := s.entries[key]
+	if !ok {
+		return validatedResponse{}, false
+	}
+
+	v := el.Value.(validatedResponse)
+	if time.Since(v.storedAt) > conditionalTTL {
+		s.remove(el)
+		return validatedResponse{}, false
+	}
+	s.lru.MoveToFront(el)
+
+	return v, true
+}
+
+func (s *conditionalStore) put(v validatedResponse) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if el, ok := s.entries[v.key]; ok {
+		s.remove(el)
+	}
+
+	s.entries[v.key] = s.lru.PushFront(v)
+	s.size += len(v.body)
+
+	for s.lru.Len() > conditionalMaxEntries || s.size > conditionalMaxBytes {
+		s.remove(s.lru.Back())
+	}
+}
+
+func (s *conditionalStore) remove(el *list.Element) {
+	v := s.lru.Remove(el).(validatedResponse)
+	delete(s.entries, v.key)
+	s.size -= len(v.body)
+}
+
+// WithConditionalRequests remembers ETag and Last-Modified validators of
+// schedule and booking reads and revalidates them with If-None-Match and
+// If-Modified-Since. A 304 Not Modified answer is served from the stored
+// response, which makes aggressive polling cheap. The store is bounded:
+// least recently used responses are evicted and large bodies are not kept.
+func WithConditionalRequests() Option {
+	return func(c *Client) error {
+		c.middlewares = append(c.middlewares, ConditionalRequests(isConditionalRead))
+		return nil
+	}
+}
+
+// isConditionalRead reports whether the request is a schedule or booking
+// read.
+func isConditionalRead(req *http.Request) bool {
+	path := req.URL.Path
+	return strings.HasSuffix(path, "/classes") || strings.Contains(path, "/bookings")
+}
+
+// ConditionalRequests returns a middleware that revalidates GET requests
+// matched by match using stored validators.
+func ConditionalRequests(match func(*http.Request) bool) Middleware {
+	store := newConditionalStore()
+
+	return func(rt http.RoundTripper) http.RoundTripper {
+		return internalRoundTripper(func(req *http.Request) (*http.Response, error) {
+			if req.Method != http.MethodGet || !match(req) {
+				return rt.RoundTrip(req)
+			}
+
+			key := req.URL.String()
+			stored, ok := store.get(key)
+			if ok {
+				req = req.Clone(req.Context())
+				if stored.etag != "" {
+					req.Header.Set("If-None-Match", stored.etag)
+				}
+				if stored.lastModified != "" {
+					req.Header.Set("If-Modified-Since", stored.lastModified)
+				}
+			}
+
+			res, err := rt.RoundTrip(req)
+			if err != nil {
+				return res, err
+			}
+
+			if res.StatusCode == http.StatusNotModified && ok {
+				res.Body.Close()
+				return &http.Response{
+					Status:        "200 OK",
+					StatusCode:    http.StatusOK,
+					Proto:         res.Proto,
+					ProtoMajor:    res.ProtoMajor,
+					ProtoMinor:    res.ProtoMinor,
+					Header:        stored.header.Clone(),
+					Body:          io.NopCloser(bytes.NewReader(stored.body)),
+					ContentLength: int64(len(stored.body)),
+					Request:       req,
+				}, nil
+			}
+
+			etag := res.Header.Get("ETag")
+			lastModified := res.Header.Get("Last-Modified")
+			if res.StatusCode != http.StatusOK || (etag == "" && lastModified == "") ||
+				res.ContentLength > conditionalMaxBodySize {
+				return res, nil
+			}
+
+			body, err := io.ReadAll(io.LimitReader(res.Body, conditionalMaxBodySize+1))
+			if err != nil {
+				res.Body.Close()
+				return nil, err
+			}
+			if len(body) > conditionalMaxBodySize {
+				// Too large to keep; hand the rest of the body through.
+				res.Body = struct {
+					io.Reader
+					io.Closer
+				}{io.MultiReader(bytes.NewReader(body), res.Body), res.Body}
+				return res, nil
+			}
+			res.Body.Close()
+
+			store.put(validatedResponse{
+				key:          key,
+				etag:         etag,
+				lastModified: lastModified,
+				header:       res.Header.Clone(),
+				body:         body,
+				storedAt:     time.Now(),
+			})
+
+			res.Body = io.NopCloser(bytes.NewReader(body))
+			return res, nil
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package otf_api
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	earthRadiusMiles = 3958.8
+
+	// AverageTravelSpeedMPH is the speed assumed when estimating how long
+	// it takes to get from one studio to another.
+	AverageTravelSpeedMPH = 25.0
+)
+
+// Haversine returns the great-circle distance in miles between two
+// lat/long points.
+func Haversine(lat1, long1, lat2, long2 float64) float64 {
+	toRad := func(deg float64) float64 { return deg * math.Pi / 180 }
+
+	dLat := toRad(lat2 - lat1)
+	dLong := toRad(long2 - long1)
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRad(lat1))*math.Cos(toRad(lat2))*math.Sin(dLong/2)*math.Sin(dLong/2)
+
+	return 2 * earthRadiusMiles * math.Asin(math.Sqrt(a))
+}
+
+// EstimateTravelTime returns the time needed to cover distance miles at
+// AverageTravelSpeedMPH.
+func EstimateTravelTime(distance float64) time.Duration {
+	return time.Duration(distance / AverageTravelSpeedMPH * float64(time.Hour))
+}
+
+// FindConflicts returns the bookings that overlap the candidate class or
+// leave too little time to get between the two studios. travelBuffer is
+// added on top of the travel time estimated from the distance between
+// the studios. Cancelled bookings never conflict.
+func FindConflicts(
+	bookings []Booking,
+	candidate StudioClass,
+	travelBuffer time.Duration,
+) []Booking {
+	var conflicts []Booking
+
+	for _, b := range bookings {
+		if b.Status == BookingStatusCancelled {
+			continue
+		}
+
+		gap := travelBuffer + EstimateTravelTime(studioDistance(b.Class.Studio, candidate.Studio))
+
+		if candidate.StartsAt.Before(b.Class.EndDateTime.Add(gap)) &&
+			b.Class.StartDateTime.Before(candidate.EndsAt.Add(gap)) {
+			conflicts = append(conflicts, b)
+		}
+	}
+
+	return conflicts
+}
+
+// studioDistance returns the distance in miles between a booked studio and
+// a schedule studio, or zero when either location is unknown.
+func studioDistance(booked Studio, candidate StudioClassStudio) float64 {
+	loc := booked.StudioLocation
+	if booked.StudioUUID == candidate.ID ||
+		(loc.Latitude == 0 && loc.Longitude == 0) ||
+		(candidate.Latitude == 0 && candidate.Longitude == 0) {
+		return 0
+	}
+
+	return Haversine(loc.Latitude, loc.Longitude, candidate.Latitude, candidate.Longitude)
+}
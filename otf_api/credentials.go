@@ -0,0 +1,152 @@
+package otf_api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+const (
+	UsernameEnvVar = "OTF_USERNAME"
+	PasswordEnvVar = "OTF_PASSWORD"
+)
+
+// ErrNoCredentials is returned by a CredentialsProvider that has no
+// credentials to offer. ChainCredentials moves on to the next provider
+// when it sees this error.
+var ErrNoCredentials = errors.New("no credentials available")
+
+// CredentialsProvider supplies the username and password used to
+// authenticate against the OTF API.
+type CredentialsProvider interface {
+	Credentials(ctx context.Context) (Credentials, error)
+}
+
+// CredentialsProviderFunc adapts a function to a CredentialsProvider, e.g.
+// to read from an OS keychain or prompt interactively.
+type CredentialsProviderFunc func(ctx context.Context) (Credentials, error)
+
+func (f CredentialsProviderFunc) Credentials(ctx context.Context) (Credentials, error) {
+	return f(ctx)
+}
+
+// StaticCredentials returns a provider for explicitly passed credentials,
+// such as command line flags. Empty values yield ErrNoCredentials.
+func StaticCredentials(username string, password string) CredentialsProvider {
+	return CredentialsProviderFunc(func(context.Context) (Credentials, error) {
+		if username == "" || password == "" {
+			return Credentials{}, ErrNoCredentials
+		}
+
+		return Credentials{Username: username, Password: password}, nil
+	})
+}
+
+// EnvCredentials returns a provider reading OTF_USERNAME and OTF_PASSWORD
+// from the environment (or a .env file in the working directory).
+func EnvCredentials() CredentialsProvider {
+	return CredentialsProviderFunc(func(ctx context.Context) (Credentials, error) {
+		return StaticCredentials(
+			getEnvVar(UsernameEnvVar),
+			getEnvVar(PasswordEnvVar),
+		).Credentials(ctx)
+	})
+}
+
+// FileCredentials returns a provider reading a JSON file of the form
+// {"username": "...", "password": "..."}. A missing file yields
+// ErrNoCredentials.
+func FileCredentials(path string) CredentialsProvider {
+	return CredentialsProviderFunc(func(ctx context.Context) (Credentials, error) {
+		data, err := os.ReadFile(path)
+		if errors.Is(err, os.ErrNotExist) {
+			return Credentials{}, ErrNoCredentials
+		}
+		if err != nil {
+			return Credentials{}, fmt.Errorf("error reading credentials file: %w", err)
+		}
+
+		file := struct {
+			Username string `json:"username"`
+			Password string `json:"password"`
+		}{}
+		err = json.Unmarshal(data, &file)
+		if err != nil {
+			return Credentials{}, fmt.Errorf("error parsing credentials file %s: %w", path, err)
+		}
+
+		return StaticCredentials(file.Username, file.Password).Credentials(ctx)
+	})
+}
+
+// ChainCredentials returns a provider that tries each provider in order and
+// returns the first credentials found. Providers returning ErrNoCredentials
+// are skipped; any other error stops the chain.
+func ChainCredentials(providers ...CredentialsProvider) CredentialsProvider {
+	return CredentialsProviderFunc(func(ctx context.Context) (Credentials, error) {
+		for _, p := range providers {
+			creds, err := p.Credentials(ctx)
+			if errors.Is(err, ErrNoCredentials) {
+				continue
+			}
+			if err != nil {
+				return Credentials{}, err
+			}
+
+			return creds, nil
+		}
+
+		return Credentials{}, ErrNoCredentials
+	})
+}
+
+// DefaultCredentialsFile returns the path of the credentials file under the
+// user's config directory, e.g. ~/.config/otf-cli/credentials.json.
+func DefaultCredentialsFile() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, "otf-cli", "credentials.json"), nil
+}
+
+// DefaultCredentials returns the environment → config file chain used when
+// no provider is configured on the client.
+func DefaultCredentials() CredentialsProvider {
+	providers := []CredentialsProvider{EnvCredentials()}
+	if path, err := DefaultCredentialsFile(); err == nil {
+		providers = append(providers, FileCredentials(path))
+	}
+
+	return ChainCredentials(providers...)
+}
+
+// WithCredentials sets the provider Login uses to obtain credentials.
+// Combine providers with ChainCredentials to express precedence, e.g.
+// flags → environment → config file → keychain → prompt.
+func WithCredentials(p CredentialsProvider) Option {
+	return func(c *Client) error {
+		c.credentials = p
+		return nil
+	}
+}
+
+// Login authenticates with credentials from the configured provider,
+// falling back to DefaultCredentials.
+func (c *Client) Login(ctx context.Context) (AuthResult, error) {
+	p := c.credentials
+	if p == nil {
+		p = DefaultCredentials()
+	}
+
+	creds, err := p.Credentials(ctx)
+	if err != nil {
+		return AuthResult{}, fmt.Errorf("error resolving credentials: %w", err)
+	}
+
+	return c.Authenticate(ctx, creds.Username, creds.Password)
+}
@@ -0,0 +1,39 @@
+package otf_api
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+)
+
+// Date is a calendar date as returned by the OTF API. It accepts both
+// plain dates ("2024-05-01") and full RFC 3339 timestamps.
+type Date struct {
+	time.Time
+}
+
+func (d *Date) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) || bytes.Equal(data, []byte(`""`)) {
+		d.Time = time.Time{}
+		return nil
+	}
+
+	s := string(bytes.Trim(data, `"`))
+	for _, layout := range []string{dateLayout, time.RFC3339Nano, "2006-01-02T15:04:05"} {
+		t, err := time.Parse(layout, s)
+		if err == nil {
+			d.Time = t
+			return nil
+		}
+	}
+
+	return fmt.Errorf("invalid date %q", s)
+}
+
+func (d Date) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
+
+	return []byte(`"` + d.Format(dateLayout) + `"`), nil
+}
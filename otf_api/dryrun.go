@@ -0,0 +1,46 @@
+package otf_api
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+)
+
+// WithDryRun makes booking and cancellation methods run their validation,
+// conflict checks and policy lookups but log the final write instead of
+// sending it. Bookings returned in dry-run mode have no BookingUUID.
+func WithDryRun() Option {
+	return func(c *Client) error {
+		c.dryRun = true
+		return nil
+	}
+}
+
+// logDryRun logs the write request that was skipped.
+func logDryRun(req *http.Request, body any) {
+	if body == nil {
+		log.Printf("otf_api: dry run: %s %s", req.Method, req.URL.Redacted())
+		return
+	}
+
+	b, err := json.Marshal(body)
+	if err != nil {
+		log.Printf("otf_api: dry run: %s %s", req.Method, req.URL.Redacted())
+		return
+	}
+
+	log.Printf("otf_api: dry run: %s %s %s", req.Method, req.URL.Redacted(), b)
+}
+
+// dryRunBooking is the booking BookClass returns in dry-run mode.
+func dryRunBooking(bookingReq CreateBookingRequest) *Booking {
+	status := BookingStatusBooked
+	if bookingReq.Waitlist {
+		status = BookingStatusWaitlisted
+	}
+
+	return &Booking{
+		Status: status,
+		Class:  BookingClass{ClassUUID: bookingReq.ClassUUID},
+	}
+}
@@ -0,0 +1,168 @@
+package otf_api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"syscall"
+)
+
+// maxErrorBodySize caps how much of an error response body is kept
+// on an APIError.
+const maxErrorBodySize = 64 << 10
+
+// ErrMemberIDRequired is returned by member-scoped endpoints when the
+// client does not know the member ID yet.
+var ErrMemberIDRequired = errors.New("member id is required")
+
+// Transport-level failure classes. Errors returned by the client match
+// these with errors.Is, e.g. errors.Is(err, otf_api.ErrTimeout).
+var (
+	ErrTimeout      = errors.New("request timed out")
+	ErrDNS          = errors.New("dns lookup failed")
+	ErrConnReset    = errors.New("connection reset")
+	ErrConnRefused  = errors.New("connection refused")
+	ErrNetworkOther = errors.New("network error")
+)
+
+// NetworkError wraps a transport-level failure with its classification.
+type NetworkError struct {
+	Kind error
+	Err  error
+}
+
+func (e *NetworkError) Error() string {
+	return fmt.Sprintf("%v: %v", e.Kind, e.Err)
+}
+
+func (e *NetworkError) Unwrap() []error {
+	return []error{e.Kind, e.Err}
+}
+
+// classifyNetworkError wraps err in a *NetworkError describing the kind of
+// transport failure. Cancellation by the caller is returned unchanged.
+func classifyNetworkError(err error) error {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return err
+	}
+
+	var (
+		dnsErr *net.DNSError
+		netErr net.Error
+	)
+
+	kind := ErrNetworkOther
+	switch {
+	case errors.Is(err, context.DeadlineExceeded),
+		errors.As(err, &netErr) && netErr.Timeout():
+		kind = ErrTimeout
+	case errors.As(err, &dnsErr):
+		kind = ErrDNS
+	case errors.Is(err, syscall.ECONNRESET), errors.Is(err, io.ErrUnexpectedEOF):
+		kind = ErrConnReset
+	case errors.Is(err, syscall.ECONNREFUSED):
+		kind = ErrConnRefused
+	}
+
+	return &NetworkError{Kind: kind, Err: err}
+}
+
+// APIError is returned when the OTF API responds with a non-2xx status
+// code. The raw response payload is kept so callers can inspect any
+// error details the server sent back.
+type APIError struct {
+	StatusCode int
+	Status     string
+	Method     string
+	URL        string
+	Body       []byte
+}
+
+func (e *APIError) Error() string {
+	if len(e.Body) == 0 {
+		return fmt.Sprintf("%s %s: unexpected status %s", e.Method, e.URL, e.Status)
+	}
+
+	return fmt.Sprintf("%s %s: unexpected status %s: %s", e.Method, e.URL, e.Status, e.Body)
+}
+
+// FieldError describes why the server rejected a single request field.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ValidationError is returned when the server rejects a request payload
+// (400 or 422) with per-field details, or when a request fails validation
+// before it is sent, in which case Err is nil.
+type ValidationError struct {
+	Message string       `json:"message"`
+	Fields  []FieldError `json:"errors"`
+	Err     *APIError    `json:"-"`
+}
+
+func (e *ValidationError) Error() string {
+	msg := e.Message
+	if msg == "" {
+		msg = "validation failed"
+	}
+	for _, f := range e.Fields {
+		msg += fmt.Sprintf("; %s: %s", f.Field, f.Message)
+	}
+
+	return msg
+}
+
+func (e *ValidationError) Unwrap() error {
+	if e.Err == nil {
+		return nil
+	}
+
+	return e.Err
+}
+
+// asValidationError converts a 400/422 *APIError carrying field errors
+// into a *ValidationError. Other errors are returned unchanged.
+func asValidationError(err error) error {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return err
+	}
+	if apiErr.StatusCode != http.StatusBadRequest &&
+		apiErr.StatusCode != http.StatusUnprocessableEntity {
+		return err
+	}
+
+	valErr := &ValidationError{Err: apiErr}
+	if json.Unmarshal(apiErr.Body, valErr) != nil || (valErr.Message == "" && len(valErr.Fields) == 0) {
+		return err
+	}
+
+	return valErr
+}
+
+// checkResponse returns an *APIError when the response status code is
+// outside of the 2xx range.
+func checkResponse(res *http.Response) error {
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		return nil
+	}
+
+	body, _ := io.ReadAll(io.LimitReader(res.Body, maxErrorBodySize))
+
+	apiErr := &APIError{
+		StatusCode: res.StatusCode,
+		Status:     res.Status,
+		Body:       body,
+	}
+	if res.Request != nil {
+		apiErr.Method = res.Request.Method
+		apiErr.URL = res.Request.URL.Redacted()
+	}
+
+	return apiErr
+}
@@ -0,0 +1,60 @@
+package otf_api
+
+import "time"
+
+// BookingEventType describes what the client did or detected.
+type BookingEventType string
+
+const (
+	BookingEventBooked           BookingEventType = "booked"
+	BookingEventWaitlisted       BookingEventType = "waitlisted"
+	BookingEventCanceled         BookingEventType = "canceled"
+	BookingEventWaitlistPromoted BookingEventType = "waitlist_promoted"
+)
+
+// BookingEvent is delivered to OnBookingEvent handlers.
+type BookingEvent struct {
+	Type    BookingEventType
+	Booking Booking
+	// Cancel is set for BookingEventCanceled.
+	Cancel *CancelResult
+	// DryRun is set when the write was skipped because of WithDryRun.
+	DryRun bool
+	At     time.Time
+}
+
+// OnBookingEvent registers fn to be called when the client books, cancels
+// or detects a waitlist promotion. Handlers run synchronously on the
+// calling goroutine, in registration order, so they should return quickly.
+func (c *Client) OnBookingEvent(fn func(BookingEvent)) {
+	c.eventMu.Lock()
+	defer c.eventMu.Unlock()
+
+	c.bookingHandlers = append(c.bookingHandlers, fn)
+}
+
+func (c *Client) emit(ev BookingEvent) {
+	c.eventMu.RLock()
+	handlers := c.bookingHandlers
+	c.eventMu.RUnlock()
+
+	if ev.At.IsZero() {
+		ev.At = time.Now()
+	}
+	for _, fn := range handlers {
+		fn(ev)
+	}
+}
+
+func (c *Client) emitBooked(booking *Booking, dryRun bool) {
+	evType := BookingEventBooked
+	if booking.Status == BookingStatusWaitlisted {
+		evType = BookingEventWaitlisted
+	}
+
+	c.emit(BookingEvent{Type: evType, Booking: *booking, DryRun: dryRun})
+}
+
+func (c *Client) emitCanceled(booking Booking, result CancelResult, dryRun bool) {
+	c.emit(BookingEvent{Type: BookingEventCanceled, Booking: booking, Cancel: &result, DryRun: dryRun})
+}
@@ -0,0 +1,65 @@
+package otf_api
+
+import (
+	"context"
+	"net/http"
+)
+
+type FavoriteStudiosRequest struct {
+	StudioUUIDs []string `json:"studioUUIds"`
+}
+
+type FavoriteStudiosResponse struct {
+	Data []Studio `json:"data"`
+}
+
+// GetFavoriteStudios returns the studios the member marked as favorites.
+func (c *Client) GetFavoriteStudios(ctx context.Context) ([]Studio, error) {
+	u, err := c.memberURL("favorite-studios")
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedResp := FavoriteStudiosResponse{}
+	err = c.do(req, &parsedResp)
+	if err != nil {
+		return nil, err
+	}
+
+	return parsedResp.Data, nil
+}
+
+// AddFavoriteStudio adds the studios to the member's favorites.
+func (c *Client) AddFavoriteStudio(ctx context.Context, studioUUIDs ...string) error {
+	return c.updateFavoriteStudios(ctx, http.MethodPost, studioUUIDs)
+}
+
+// RemoveFavoriteStudio removes the studios from the member's favorites.
+func (c *Client) RemoveFavoriteStudio(ctx context.Context, studioUUIDs ...string) error {
+	return c.updateFavoriteStudios(ctx, http.MethodDelete, studioUUIDs)
+}
+
+func (c *Client) updateFavoriteStudios(
+	ctx context.Context,
+	method string,
+	studioUUIDs []string,
+) error {
+	u, err := c.memberURL("favorite-studios")
+	if err != nil {
+		return err
+	}
+
+	req, err := newJSONRequest(ctx, method, u, FavoriteStudiosRequest{
+		StudioUUIDs: studioUUIDs,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.do(req, nil)
+}
@@ -0,0 +1,38 @@
+package otf_api
+
+import (
+	"context"
+	"strings"
+)
+
+// Guest is a person booked into a class by the member, e.g. with a guest
+// pass.
+type Guest struct {
+	FirstName   string `json:"firstName"`
+	LastName    string `json:"lastName"`
+	Email       string `json:"email"`
+	PhoneNumber string `json:"phoneNumber,omitempty"`
+	// DateOfBirth is required by some studios' waivers.
+	DateOfBirth *Date `json:"dateOfBirth,omitempty"`
+}
+
+// same reports whether g and other are the same guest, or both absent.
+func (g *Guest) same(other *Guest) bool {
+	if g == nil || other == nil {
+		return g == nil && other == nil
+	}
+
+	return strings.EqualFold(g.Email, other.Email)
+}
+
+// BookClassWithGuest books a class for a guest of the member.
+func (c *Client) BookClassWithGuest(
+	ctx context.Context,
+	classID string,
+	guest Guest,
+) (*Booking, error) {
+	return c.BookClass(ctx, CreateBookingRequest{
+		ClassUUID: classID,
+		Guest:     &guest,
+	})
+}
@@ -0,0 +1,97 @@
+package otf_api
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+const (
+	BookingStatusNoShow        = "No Show"
+	BookingStatusLateCancelled = "Late Cancelled"
+)
+
+const (
+	// bookingHistoryWindow is the widest date range GetBookings returns
+	// completely.
+	bookingHistoryWindow = 60
+	// defaultBookingHistoryDays is how far back history goes by default.
+	defaultBookingHistoryDays = 365
+)
+
+// Attended reports whether the member checked in to the class.
+func (b Booking) Attended() bool {
+	return b.Status == BookingStatusCheckedIn
+}
+
+// NoShow reports whether the member missed a class they were booked for.
+func (b Booking) NoShow() bool {
+	return b.Status == BookingStatusNoShow
+}
+
+// HistoryOption narrows a booking history query.
+type HistoryOption func(*historyQuery)
+
+type historyQuery struct {
+	since time.Time
+	until time.Time
+}
+
+// WithHistorySince stops the history at t. It defaults to one year ago.
+func WithHistorySince(t time.Time) HistoryOption {
+	return func(q *historyQuery) {
+		q.since = t
+	}
+}
+
+// WithHistoryUntil starts the history at t instead of now.
+func WithHistoryUntil(t time.Time) HistoryOption {
+	return func(q *historyQuery) {
+		q.until = t
+	}
+}
+
+// BookingHistoryIterator walks the member's bookings backwards from the
+// newest, one 60-day window per page. Each booking's Status carries its
+// attendance: checked in, no show, cancelled or late cancelled.
+func (c *Client) BookingHistoryIterator(ctx context.Context, opts ...HistoryOption) *PageIterator[Booking] {
+	q := &historyQuery{
+		until: time.Now(),
+	}
+	for _, opt := range opts {
+		opt(q)
+	}
+	if q.since.IsZero() {
+		q.since = q.until.AddDate(0, 0, -defaultBookingHistoryDays)
+	}
+
+	days := int(q.until.Sub(q.since).Hours()/24) + 1
+	totalPages := max((days+bookingHistoryWindow-1)/bookingHistoryWindow, 1)
+
+	return NewPageIterator(ctx, func(ctx context.Context, pageIndex int) ([]Booking, Pagination, error) {
+		// Windows are whole, non-overlapping date ranges since GetBookings
+		// includes both ends.
+		end := q.until.AddDate(0, 0, -(pageIndex-1)*bookingHistoryWindow)
+		start := end.AddDate(0, 0, -(bookingHistoryWindow - 1))
+		if start.Before(q.since) {
+			start = q.since
+		}
+
+		bookings, err := c.GetBookings(ctx, start, end)
+		if err != nil {
+			return nil, Pagination{}, err
+		}
+
+		sort.SliceStable(bookings, func(i, j int) bool {
+			return bookings[i].Class.StartDateTime.After(bookings[j].Class.StartDateTime)
+		})
+
+		return bookings, Pagination{PageIndex: pageIndex, TotalPages: totalPages}, nil
+	})
+}
+
+// GetBookingHistory returns the member's bookings, newest first. See
+// BookingHistoryIterator.
+func (c *Client) GetBookingHistory(ctx context.Context, opts ...HistoryOption) ([]Booking, error) {
+	return c.BookingHistoryIterator(ctx, opts...).Collect()
+}
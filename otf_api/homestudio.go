@@ -0,0 +1,76 @@
+package otf_api
+
+import (
+	"context"
+	"fmt"
+)
+
+// HomeStudioUUID returns the member's home studio, taken from the token
+// claims when present and otherwise from the member profile.
+func (c *Client) HomeStudioUUID(ctx context.Context) (string, error) {
+	claims, err := c.Claims()
+	if err == nil && claims.HomeStudioUUID != "" {
+		return claims.HomeStudioUUID, nil
+	}
+
+	member, err := c.GetMemberDetail(ctx)
+	if err != nil {
+		return "", fmt.Errorf("error looking up home studio: %w", err)
+	}
+
+	return member.HomeStudio.StudioUUID, nil
+}
+
+// IsHomeStudio reports whether the class takes place at the home studio.
+func (sc StudioClass) IsHomeStudio(homeStudioUUID string) bool {
+	return homeStudioUUID != "" && sc.Studio.ID == homeStudioUUID
+}
+
+// HomeStudio returns the member's home studio, including its location.
+func (c *Client) HomeStudio(ctx context.Context) (Studio, error) {
+	member, err := c.GetMemberDetail(ctx)
+	if err != nil {
+		return Studio{}, fmt.Errorf("error looking up home studio: %w", err)
+	}
+
+	return member.HomeStudio, nil
+}
+
+// IsCrossRegional reports whether booking the class would likely be a
+// cross-regional booking, which follows different cancellation and credit
+// policies. The API does not expose OTF's region boundaries, so this is an
+// approximation: classes in a different country or state/province than the
+// home studio count as cross-regional. It returns false when either
+// location is unknown.
+func (sc StudioClass) IsCrossRegional(home Studio) bool {
+	if sc.Studio.ID == home.StudioUUID {
+		return false
+	}
+
+	// The .co studio API and the .io schedule API format locations
+	// differently ("US" vs "United States", "CA" vs "California"), so
+	// both sides are normalized to ISO 3166 codes before comparing.
+	homeCountry := countryCode(home.StudioLocation.PhysicalCountry)
+	classCountry := countryCode(sc.Studio.Address.Country)
+	if homeCountry == "" || classCountry == "" {
+		return false
+	}
+	if homeCountry != classCountry {
+		return true
+	}
+
+	homeState := subdivisionCode(home.StudioLocation.PhysicalState)
+	classState := subdivisionCode(sc.Studio.Address.State)
+	if homeState == "" || classState == "" {
+		return false
+	}
+
+	return homeState != classState
+}
+
+// WithHomeStudioOnly only returns classes at the member's home studio.
+func WithHomeStudioOnly() ScheduleOption {
+	return func(q *scheduleQuery) {
+		q.homeStudioOnly = true
+	}
+}
@@ -0,0 +1,84 @@
+package otf_api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const (
+	MemberUUIDQueryParamKey = "memberUuid"
+
+	minMaxHR = 100
+	maxMaxHR = 250
+)
+
+type HRZoneProfile struct {
+	MaxHR int `json:"maxHr"`
+	// Source tells whether MaxHR was set manually or calculated, e.g.
+	// from age.
+	Source string  `json:"maxHrType"`
+	Zones  HRZones `json:"zones"`
+}
+
+type UpdateMaxHRRequest struct {
+	MemberUUID string `json:"memberUuid"`
+	MaxHR      int    `json:"maxHr"`
+}
+
+// GetHRZoneProfile returns the member's configured max heart rate and the
+// zone thresholds derived from it.
+func (c *Client) GetHRZoneProfile(ctx context.Context) (HRZoneProfile, error) {
+	memberID, err := c.requireMemberID()
+	if err != nil {
+		return HRZoneProfile{}, err
+	}
+
+	params := url.Values{
+		MemberUUIDQueryParamKey: {memberID},
+	}
+
+	u := c.BaseTelemetryURL + "physVars/maxHr?" + params.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return HRZoneProfile{}, err
+	}
+
+	parsedResp := HRZoneProfile{}
+	err = c.do(req, &parsedResp)
+	if err != nil {
+		return HRZoneProfile{}, err
+	}
+
+	return parsedResp, nil
+}
+
+// UpdateMaxHR sets the member's max heart rate and returns the updated
+// zone profile.
+func (c *Client) UpdateMaxHR(ctx context.Context, maxHR int) (HRZoneProfile, error) {
+	if maxHR < minMaxHR || maxHR > maxMaxHR {
+		return HRZoneProfile{}, fmt.Errorf("max heart rate must be between %d and %d", minMaxHR, maxMaxHR)
+	}
+
+	memberID, err := c.requireMemberID()
+	if err != nil {
+		return HRZoneProfile{}, err
+	}
+
+	req, err := newJSONRequest(ctx, http.MethodPut, c.BaseTelemetryURL+"physVars/maxHr", UpdateMaxHRRequest{
+		MemberUUID: memberID,
+		MaxHR:      maxHR,
+	})
+	if err != nil {
+		return HRZoneProfile{}, err
+	}
+
+	parsedResp := HRZoneProfile{}
+	err = c.do(req, &parsedResp)
+	if err != nil {
+		return HRZoneProfile{}, asValidationError(err)
+	}
+
+	return parsedResp, nil
+}
@@ -0,0 +1,271 @@
+// Package ics implements the minimal subset of iCalendar (RFC 5545) needed
+// to reconcile a remote calendar feed with OTF bookings.
+package ics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+const (
+	dateTimeUTCLayout = "20060102T150405Z"
+	dateTimeLayout    = "20060102T150405"
+	dateLayout        = "20060102"
+
+	// maxLineSize caps a single unfolded content line; long DESCRIPTION
+	// properties easily exceed bufio.Scanner's 64KB default.
+	maxLineSize = 4 << 20
+)
+
+// windowsZones maps the Windows time zone names Outlook and Exchange use
+// as TZIDs to IANA names.
+var windowsZones = map[string]string{
+	"Hawaiian Standard Time":         "Pacific/Honolulu",
+	"Alaskan Standard Time":          "America/Anchorage",
+	"Pacific Standard Time":          "America/Los_Angeles",
+	"US Mountain Standard Time":      "America/Phoenix",
+	"Mountain Standard Time":         "America/Denver",
+	"Central Standard Time":          "America/Chicago",
+	"Canada Central Standard Time":   "America/Regina",
+	"Eastern Standard Time":          "America/New_York",
+	"US Eastern Standard Time":       "America/Indianapolis",
+	"Atlantic Standard Time":         "America/Halifax",
+	"Newfoundland Standard Time":     "America/St_Johns",
+	"GMT Standard Time":              "Europe/London",
+	"W. Europe Standard Time":        "Europe/Berlin",
+	"Romance Standard Time":          "Europe/Paris",
+	"Central Europe Standard Time":   "Europe/Budapest",
+	"E. Australia Standard Time":     "Australia/Brisbane",
+	"AUS Eastern Standard Time":      "Australia/Sydney",
+	"Arabian Standard Time":          "Asia/Dubai",
+	"Tokyo Standard Time":            "Asia/Tokyo",
+	"China Standard Time":            "Asia/Shanghai",
+	"India Standard Time":            "Asia/Kolkata",
+	"New Zealand Standard Time":      "Pacific/Auckland",
+	"Central America Standard Time":  "America/Guatemala",
+	"SA Pacific Standard Time":       "America/Bogota",
+	"Pacific Standard Time (Mexico)": "America/Tijuana",
+}
+
+// Event is a single VEVENT component.
+type Event struct {
+	UID         string
+	Summary     string
+	Description string
+	Location    string
+	Status      string
+	Start       time.Time
+	End         time.Time
+	AllDay      bool
+}
+
+// Calendar is a parsed VCALENDAR object.
+type Calendar struct {
+	ProdID string
+	Events []Event
+}
+
+// Parse reads an iCalendar stream and returns the events it contains.
+// Components other than VEVENT and unknown properties are ignored.
+func Parse(r io.Reader) (Calendar, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return Calendar{}, fmt.Errorf("error reading calendar: %w", err)
+	}
+
+	cal := Calendar{}
+	var (
+		event   *Event
+		depth   int
+		inEvent bool
+	)
+
+	for i, line := range lines {
+		name, params, value, ok := splitLine(line)
+		if !ok {
+			return Calendar{}, fmt.Errorf("line %d: malformed content line %q", i+1, line)
+		}
+
+		switch name {
+		case "BEGIN":
+			depth++
+			if strings.EqualFold(value, "VEVENT") && !inEvent {
+				inEvent = true
+				event = &Event{}
+			}
+			continue
+		case "END":
+			depth--
+			if strings.EqualFold(value, "VEVENT") && inEvent {
+				inEvent = false
+				cal.Events = append(cal.Events, *event)
+				event = nil
+			}
+			continue
+		}
+
+		if !inEvent {
+			if name == "PRODID" {
+				cal.ProdID = value
+			}
+			continue
+		}
+
+		switch name {
+		case "UID":
+			event.UID = value
+		case "SUMMARY":
+			event.Summary = unescapeText(value)
+		case "DESCRIPTION":
+			event.Description = unescapeText(value)
+		case "LOCATION":
+			event.Location = unescapeText(value)
+		case "STATUS":
+			event.Status = strings.ToUpper(value)
+		case "DTSTART", "DTEND":
+			t, allDay, err := parseTime(value, params)
+			if err != nil {
+				return Calendar{}, fmt.Errorf("line %d: %w", i+1, err)
+			}
+
+			if name == "DTSTART" {
+				event.Start = t
+				event.AllDay = allDay
+			} else {
+				event.End = t
+			}
+		}
+	}
+
+	if depth != 0 || inEvent {
+		return Calendar{}, fmt.Errorf("unterminated calendar component")
+	}
+
+	return cal, nil
+}
+
+// unfold joins folded content lines (continuations start with a space or
+// horizontal tab) and drops empty lines.
+func unfold(r io.Reader) ([]string, error) {
+	var lines []string
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, maxLineSize)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if line == "" {
+			continue
+		}
+
+		if (line[0] == ' ' || line[0] == '\t') && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+
+		lines = append(lines, line)
+	}
+
+	return lines, scanner.Err()
+}
+
+// splitLine splits a content line into its upper-cased name, parameters
+// and value.
+func splitLine(line string) (string, map[string]string, string, bool) {
+	colon := indexUnquoted(line, ':')
+	if colon < 0 {
+		return "", nil, "", false
+	}
+
+	head, value := line[:colon], line[colon+1:]
+	parts := strings.Split(head, ";")
+	params := make(map[string]string, len(parts)-1)
+
+	for _, p := range parts[1:] {
+		k, v, found := strings.Cut(p, "=")
+		if !found {
+			continue
+		}
+		params[strings.ToUpper(k)] = strings.Trim(v, `"`)
+	}
+
+	return strings.ToUpper(parts[0]), params, value, true
+}
+
+// indexUnquoted returns the index of the first sep outside of a quoted
+// parameter value.
+func indexUnquoted(s string, sep byte) int {
+	quoted := false
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '"':
+			quoted = !quoted
+		case sep:
+			if !quoted {
+				return i
+			}
+		}
+	}
+
+	return -1
+}
+
+func parseTime(value string, params map[string]string) (time.Time, bool, error) {
+	if params["VALUE"] == "DATE" || len(value) == len(dateLayout) {
+		t, err := time.ParseInLocation(dateLayout, value, time.UTC)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("invalid date %q: %w", value, err)
+		}
+		return t, true, nil
+	}
+
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse(dateTimeUTCLayout, value)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("invalid date-time %q: %w", value, err)
+		}
+		return t, false, nil
+	}
+
+	loc := time.UTC
+	if tzid, ok := params["TZID"]; ok {
+		loc = location(tzid)
+	}
+
+	t, err := time.ParseInLocation(dateTimeLayout, value, loc)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("invalid date-time %q: %w", value, err)
+	}
+
+	return t, false, nil
+}
+
+// location resolves a TZID, accepting IANA and Windows zone names. Times
+// with an unknown TZID are treated as floating and interpreted as UTC
+// rather than failing the whole calendar.
+func location(tzid string) *time.Location {
+	if iana, ok := windowsZones[tzid]; ok {
+		tzid = iana
+	}
+
+	loc, err := time.LoadLocation(tzid)
+	if err != nil {
+		return time.UTC
+	}
+
+	return loc
+}
+
+var textUnescaper = strings.NewReplacer(
+	`\\`, `\`,
+	`\;`, `;`,
+	`\,`, `,`,
+	`\n`, "\n",
+	`\N`, "\n",
+)
+
+func unescapeText(s string) string {
+	return textUnescaper.Replace(s)
+}
@@ -0,0 +1,165 @@
+package ics
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseFixture(t *testing.T) {
+	f, err := os.Open("testdata/outlook.ics")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	cal, err := Parse(f)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	if want := "-//Microsoft Corporation//Outlook 16.0 MIMEDIR//EN"; cal.ProdID != want {
+		t.Errorf("ProdID = %q, want %q", cal.ProdID, want)
+	}
+	if len(cal.Events) != 5 {
+		t.Fatalf("got %d events, want 5", len(cal.Events))
+	}
+
+	newYork, _ := time.LoadLocation("America/New_York")
+	losAngeles, _ := time.LoadLocation("America/Los_Angeles")
+
+	tests := []struct {
+		uid    string
+		start  time.Time
+		end    time.Time
+		allDay bool
+	}{
+		{
+			uid:   "windows-tz@example.com",
+			start: time.Date(2024, 5, 1, 6, 0, 0, 0, newYork),
+			end:   time.Date(2024, 5, 1, 7, 0, 0, 0, newYork),
+		},
+		{
+			uid:   "iana-tz@example.com",
+			start: time.Date(2024, 5, 2, 17, 30, 0, 0, losAngeles),
+			end:   time.Date(2024, 5, 2, 18, 20, 0, 0, losAngeles),
+		},
+		{
+			uid:   "unknown-tz@example.com",
+			start: time.Date(2024, 5, 3, 8, 0, 0, 0, time.UTC),
+			end:   time.Date(2024, 5, 3, 8, 50, 0, 0, time.UTC),
+		},
+		{
+			uid:    "all-day@example.com",
+			start:  time.Date(2024, 5, 4, 0, 0, 0, 0, time.UTC),
+			end:    time.Date(2024, 5, 5, 0, 0, 0, 0, time.UTC),
+			allDay: true,
+		},
+		{
+			uid:   "utc@example.com",
+			start: time.Date(2024, 5, 5, 12, 0, 0, 0, time.UTC),
+			end:   time.Date(2024, 5, 5, 13, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.uid, func(t *testing.T) {
+			ev := cal.Events[i]
+			if ev.UID != tt.uid {
+				t.Fatalf("UID = %q, want %q", ev.UID, tt.uid)
+			}
+			if !ev.Start.Equal(tt.start) {
+				t.Errorf("Start = %v, want %v", ev.Start, tt.start)
+			}
+			if !ev.End.Equal(tt.end) {
+				t.Errorf("End = %v, want %v", ev.End, tt.end)
+			}
+			if ev.AllDay != tt.allDay {
+				t.Errorf("AllDay = %v, want %v", ev.AllDay, tt.allDay)
+			}
+		})
+	}
+
+	first := cal.Events[0]
+	if want := "Orangetheory Fitness, Brooklyn"; first.Location != want {
+		t.Errorf("Location = %q, want %q", first.Location, want)
+	}
+	if want := "Bring water; towel\nand shoes. Folded across two lines."; first.Description != want {
+		t.Errorf("Description = %q, want %q", first.Description, want)
+	}
+	if first.Status != "CONFIRMED" {
+		t.Errorf("Status = %q, want CONFIRMED", first.Status)
+	}
+}
+
+func TestParseLongLine(t *testing.T) {
+	description := strings.Repeat("x", 200<<10)
+	input := "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nUID:long\r\nDESCRIPTION:" + description +
+		"\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"
+
+	cal, err := Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if len(cal.Events) != 1 || cal.Events[0].Description != description {
+		t.Fatalf("long description not preserved")
+	}
+}
+
+func TestUnfold(t *testing.T) {
+	input := "A:one\r\n two\r\n\tthree\r\n\r\nB:four\n"
+
+	lines, err := unfold(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("unfold: %v", err)
+	}
+
+	want := []string{"A:onetwothree", "B:four"}
+	if len(lines) != len(want) {
+		t.Fatalf("got %q, want %q", lines, want)
+	}
+	for i := range want {
+		if lines[i] != want[i] {
+			t.Errorf("line %d = %q, want %q", i, lines[i], want[i])
+		}
+	}
+}
+
+func TestUnescapeText(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: `plain`, want: "plain"},
+		{in: `a\, b\; c`, want: "a, b; c"},
+		{in: `line\nbreak\Nagain`, want: "line\nbreak\nagain"},
+		{in: `back\\slash`, want: `back\slash`},
+		{in: `\\n`, want: `\n`},
+	}
+
+	for _, tt := range tests {
+		if got := unescapeText(tt.in); got != tt.want {
+			t.Errorf("unescapeText(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "unterminated", input: "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\n"},
+		{name: "malformed line", input: "BEGIN:VCALENDAR\r\nnot a content line\r\nEND:VCALENDAR\r\n"},
+		{name: "bad date", input: "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nDTSTART:2024XX01T060000\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Parse(strings.NewReader(tt.input)); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
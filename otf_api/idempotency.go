@@ -0,0 +1,89 @@
+package otf_api
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+)
+
+// IdempotencyKeyHeader carries the key identifying a booking attempt.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// bookingLookahead bounds how far ahead bookings are searched when
+// checking for an existing booking of a class.
+const bookingLookahead = 31 * 24 * time.Hour
+
+func newIdempotencyKey() string {
+	b := make([]byte, 16)
+	// crypto/rand.Read never returns an error on supported platforms.
+	_, _ = rand.Read(b)
+
+	return hex.EncodeToString(b)
+}
+
+// findActiveBooking returns the member's booking or waitlist spot matching
+// bookingReq's class and guest, or nil when there is none.
+func (c *Client) findActiveBooking(ctx context.Context, bookingReq CreateBookingRequest) (*Booking, error) {
+	now := time.Now()
+	bookings, err := c.GetBookings(ctx, now.AddDate(0, 0, -1), now.Add(bookingLookahead))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, b := range bookings {
+		if b.Class.ClassUUID != bookingReq.ClassUUID || !b.Guest.same(bookingReq.Guest) {
+			continue
+		}
+		if b.Status == BookingStatusBooked || b.Status == BookingStatusWaitlisted {
+			return &b, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// isUncertainFailure reports whether a request may have reached the server
+// even though no response was received.
+func isUncertainFailure(err error) bool {
+	return errors.Is(err, ErrTimeout) || errors.Is(err, ErrConnReset) || errors.Is(err, ErrNetworkOther)
+}
+
+// isDuplicateBooking reports whether the server rejected a booking because
+// the member already holds it.
+func isDuplicateBooking(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	if apiErr.StatusCode == http.StatusConflict {
+		return true
+	}
+
+	return apiErr.StatusCode == http.StatusBadRequest &&
+		bytes.Contains(bytes.ToLower(apiErr.Body), []byte("already booked"))
+}
+
+// duplicateBooking returns the existing booking a duplicate booking error
+// refers to, from the error payload when it carries one, or by looking it
+// up. It returns nil when the booking cannot be found.
+func (c *Client) duplicateBooking(ctx context.Context, err error, bookingReq CreateBookingRequest) *Booking {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		parsedResp := BookingResponse{}
+		if json.Unmarshal(apiErr.Body, &parsedResp) == nil && parsedResp.Data.BookingUUID != "" {
+			return &parsedResp.Data
+		}
+	}
+
+	existing, findErr := c.findActiveBooking(ctx, bookingReq)
+	if findErr != nil {
+		return nil
+	}
+
+	return existing
+}
@@ -0,0 +1,101 @@
+package otf_api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+)
+
+type MemberAddress struct {
+	Type       string `json:"type"`
+	Address1   string `json:"address1"`
+	Address2   string `json:"address2"`
+	City       string `json:"suburb"`
+	State      string `json:"territory"`
+	PostalCode string `json:"postalCode"`
+	Country    string `json:"country"`
+}
+
+type EmergencyContact struct {
+	Name         string `json:"name"`
+	PhoneNumber  string `json:"phone"`
+	Relationship string `json:"relationship"`
+}
+
+type Member struct {
+	MemberUUID       string            `json:"memberUUId"`
+	CognitoID        string            `json:"cognitoId"`
+	FirstName        string            `json:"firstName"`
+	LastName         string            `json:"lastName"`
+	Email            string            `json:"email"`
+	PhoneNumber      string            `json:"phoneNumber"`
+	MembershipType   string            `json:"membershipType"`
+	HomeStudio       Studio            `json:"homeStudio"`
+	Addresses        []MemberAddress   `json:"addresses"`
+	EmergencyContact *EmergencyContact `json:"emergencyContact"`
+}
+
+// UpdateMemberProfileRequest lists the profile fields to change. Nil fields
+// are left untouched.
+type UpdateMemberProfileRequest struct {
+	FirstName        *string           `json:"firstName,omitempty"`
+	LastName         *string           `json:"lastName,omitempty"`
+	PhoneNumber      *string           `json:"phoneNumber,omitempty"`
+	Address          *MemberAddress    `json:"address,omitempty"`
+	EmergencyContact *EmergencyContact `json:"emergencyContact,omitempty"`
+}
+
+type MemberDetailResponse struct {
+	Data Member `json:"data"`
+}
+
+// GetMemberDetail returns the profile of the authenticated member. The
+// member UUID is remembered on the client for member-scoped endpoints.
+func (c *Client) GetMemberDetail(ctx context.Context) (Member, error) {
+	u := c.BaseCOURL + "member/members/me"
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return Member{}, err
+	}
+
+	parsedResp := MemberDetailResponse{}
+	err = c.do(req, &parsedResp)
+	if err != nil {
+		return Member{}, err
+	}
+
+	c.rememberMemberID(parsedResp.Data.MemberUUID)
+
+	return parsedResp.Data, nil
+}
+
+// UpdateMemberProfile changes the member's name, phone number, address or
+// emergency contact and returns the updated profile. Payloads rejected by
+// the server are returned as a *ValidationError.
+func (c *Client) UpdateMemberProfile(
+	ctx context.Context,
+	update UpdateMemberProfileRequest,
+) (Member, error) {
+	if update == (UpdateMemberProfileRequest{}) {
+		return Member{}, errors.New("no profile fields to update")
+	}
+
+	u, err := c.memberURL()
+	if err != nil {
+		return Member{}, err
+	}
+
+	req, err := newJSONRequest(ctx, http.MethodPut, u, update)
+	if err != nil {
+		return Member{}, err
+	}
+
+	parsedResp := MemberDetailResponse{}
+	err = c.do(req, &parsedResp)
+	if err != nil {
+		return Member{}, asValidationError(err)
+	}
+
+	return parsedResp.Data, nil
+}
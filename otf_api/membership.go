@@ -0,0 +1,60 @@
+package otf_api
+
+import (
+	"context"
+	"net/http"
+)
+
+type Membership struct {
+	MembershipUUID string `json:"membershipUUId"`
+	PlanName       string `json:"name"`
+	Status         string `json:"status"`
+	BillingStatus  string `json:"billingStatus"`
+	IsUnlimited    bool   `json:"isUnlimited"`
+	// ClassCredits is the number of classes included per billing period
+	// on limited plans.
+	ClassCredits int `json:"count"`
+	// RemainingCredits is the number of classes left in the current
+	// billing period on limited plans.
+	RemainingCredits int  `json:"remaining"`
+	StartDate        Date `json:"startDate"`
+	RenewalDate      Date `json:"nextPaymentDate"`
+}
+
+type MembershipResponse struct {
+	Data Membership `json:"data"`
+}
+
+// HasCredits reports whether the member can book another class under the
+// current billing period.
+func (m Membership) HasCredits() bool {
+	return m.IsUnlimited || m.RemainingCredits > 0
+}
+
+// LowOnCredits reports whether a limited plan has threshold or fewer
+// classes left in the current billing period.
+func (m Membership) LowOnCredits(threshold int) bool {
+	return !m.IsUnlimited && m.RemainingCredits <= threshold
+}
+
+// GetMembership returns the member's current membership plan, billing
+// status and remaining class credits.
+func (c *Client) GetMembership(ctx context.Context) (Membership, error) {
+	u, err := c.memberURL("memberships")
+	if err != nil {
+		return Membership{}, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return Membership{}, err
+	}
+
+	parsedResp := MembershipResponse{}
+	err = c.do(req, &parsedResp)
+	if err != nil {
+		return Membership{}, err
+	}
+
+	return parsedResp.Data, nil
+}
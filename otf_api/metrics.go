@@ -0,0 +1,43 @@
+package otf_api
+
+import (
+	"net/http"
+	"time"
+)
+
+// MetricsRecorder receives one observation per API call. Implementations
+// can feed request counters, error rates and duration histograms of their
+// metrics system (e.g. Prometheus) from it.
+type MetricsRecorder interface {
+	// ObserveRequest is called after every request. endpoint is a
+	// low-cardinality name such as "GET /classes"; statusCode is zero when
+	// the request failed before a response was received.
+	ObserveRequest(endpoint string, statusCode int, duration time.Duration, err error)
+}
+
+// WithMetricsRecorder reports every API call to the given recorder.
+func WithMetricsRecorder(r MetricsRecorder) Option {
+	return func(c *Client) error {
+		c.middlewares = append(c.middlewares, Metrics(r))
+		return nil
+	}
+}
+
+// Metrics returns a middleware that reports request outcomes and
+// latencies to the given recorder.
+func Metrics(r MetricsRecorder) Middleware {
+	return func(rt http.RoundTripper) http.RoundTripper {
+		return internalRoundTripper(func(req *http.Request) (*http.Response, error) {
+			start := time.Now()
+			res, err := rt.RoundTrip(req)
+
+			statusCode := 0
+			if res != nil {
+				statusCode = res.StatusCode
+			}
+			r.ObserveRequest(endpointName(req), statusCode, time.Since(start), err)
+
+			return res, err
+		})
+	}
+}
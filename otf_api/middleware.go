@@ -1,6 +1,9 @@
 package otf_api
 
-import "net/http"
+import (
+	"net/http"
+	"strings"
+)
 
 type internalRoundTripper func(*http.Request) (*http.Response, error)
 
@@ -37,3 +40,38 @@ func AddHeader(key string, value string) Middleware {
 		})
 	}
 }
+
+// endpointName returns a low-cardinality name for the request such as
+// "GET /member/members/{id}/bookings", replacing identifier path segments
+// with a placeholder so it can be used as a span name or metric label.
+func endpointName(req *http.Request) string {
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	for i, s := range segments {
+		if isIdentifier(s) {
+			segments[i] = "{id}"
+		}
+	}
+
+	return req.Method + " /" + strings.Join(segments, "/")
+}
+
+// isIdentifier reports whether a path segment looks like a UUID or a
+// numeric ID.
+func isIdentifier(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	digits := 0
+	for _, r := range s {
+		switch {
+		case r >= '0' && r <= '9':
+			digits++
+		case r == '-' || (r >= 'a' && r <= 'f') || (r >= 'A' && r <= 'F'):
+		default:
+			return false
+		}
+	}
+
+	return digits == len(s) || (len(s) >= 32 && digits > 0)
+}
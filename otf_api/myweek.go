@@ -0,0 +1,156 @@
+package otf_api
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// MyWeek is a snapshot of the member's upcoming week, fetched in one go so
+// every part reflects the same point in time.
+type MyWeek struct {
+	FetchedAt time.Time
+	Start     time.Time
+	End       time.Time
+	// Bookings are confirmed bookings in the window.
+	Bookings []Booking
+	// Waitlists are waitlisted bookings in the window.
+	Waitlists []Booking
+	// Openings are classes with open spots at the preferred studios that
+	// the member has not booked yet.
+	Openings []StudioClass
+	// Goal is the progress towards the weekly class goal, or nil when no
+	// goal is set with WithWeeklyGoal.
+	Goal *WeeklyGoal
+}
+
+// WeeklyGoal is the progress towards a weekly class goal. Attended counts
+// classes taken so far in the current calendar week, as reported by the
+// member stats.
+type WeeklyGoal struct {
+	Target   int
+	Attended int
+}
+
+// Remaining returns how many more classes are needed to meet the goal.
+func (g WeeklyGoal) Remaining() int {
+	return max(g.Target-g.Attended, 0)
+}
+
+// Met reports whether the goal has been reached.
+func (g WeeklyGoal) Met() bool {
+	return g.Attended >= g.Target
+}
+
+// WithPreferredStudios sets the studios GetMyWeek looks for openings at.
+func WithPreferredStudios(studioIDs ...string) Option {
+	return func(c *Client) error {
+		c.preferredStudios = studioIDs
+		return nil
+	}
+}
+
+// WithWeeklyGoal sets the number of classes per week GetMyWeek reports
+// goal progress against. The API does not store goals, so they are
+// configured on the client.
+func WithWeeklyGoal(classes int) Option {
+	return func(c *Client) error {
+		if classes < 0 {
+			return fmt.Errorf("weekly goal must not be negative")
+		}
+
+		c.weeklyGoal = classes
+		return nil
+	}
+}
+
+// GetMyWeek returns the member's bookings, waitlists and open classes at
+// the preferred studios for the seven days starting now, along with the
+// weekly goal progress when a goal is set. Bookings, schedules and stats
+// are fetched concurrently.
+func (c *Client) GetMyWeek(ctx context.Context) (MyWeek, error) {
+	now := time.Now()
+	week := MyWeek{
+		FetchedAt: now,
+		Start:     now,
+		End:       now.AddDate(0, 0, 7),
+	}
+
+	var (
+		wg          sync.WaitGroup
+		bookings    []Booking
+		schedule    StudioScheduleResponse
+		stats       MemberStats
+		bookingsErr error
+		scheduleErr error
+		statsErr    error
+	)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		bookings, bookingsErr = c.GetBookings(ctx, week.Start, week.End)
+	}()
+
+	if len(c.preferredStudios) > 0 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			schedule, scheduleErr = c.GetStudiosSchedules(ctx, c.preferredStudios)
+		}()
+	}
+
+	if c.weeklyGoal > 0 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			stats, statsErr = c.GetMemberStats(ctx, StatsPeriodThisWeek)
+		}()
+	}
+
+	wg.Wait()
+
+	if bookingsErr != nil {
+		return MyWeek{}, fmt.Errorf("error fetching bookings: %w", bookingsErr)
+	}
+	if scheduleErr != nil {
+		return MyWeek{}, fmt.Errorf("error fetching schedules: %w", scheduleErr)
+	}
+	if statsErr != nil {
+		return MyWeek{}, fmt.Errorf("error fetching weekly stats: %w", statsErr)
+	}
+
+	if c.weeklyGoal > 0 {
+		week.Goal = &WeeklyGoal{
+			Target:   c.weeklyGoal,
+			Attended: stats.AllStats.TotalClasses,
+		}
+	}
+
+	booked := make(map[string]bool, len(bookings))
+	for _, b := range bookings {
+		switch b.Status {
+		case BookingStatusBooked:
+			week.Bookings = append(week.Bookings, b)
+		case BookingStatusWaitlisted:
+			week.Waitlists = append(week.Waitlists, b)
+		default:
+			continue
+		}
+		booked[b.Class.ClassUUID] = true
+	}
+
+	for _, sc := range schedule.Items {
+		if sc.IsFull() || booked[sc.ID] {
+			continue
+		}
+		if sc.StartsAt.Before(week.Start) || !sc.StartsAt.Before(week.End) {
+			continue
+		}
+
+		week.Openings = append(week.Openings, sc)
+	}
+
+	return week, nil
+}
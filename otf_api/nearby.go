@@ -0,0 +1,67 @@
+package otf_api
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// NearbyClass is a class annotated with its studio's distance in miles
+// from the searched point.
+type NearbyClass struct {
+	StudioClass
+	Distance float64
+}
+
+// GetClassesNearby returns the classes starting within timeWindow from now
+// at studios within distance miles of the lat/long point, ordered by start
+// time and then distance.
+func (c *Client) GetClassesNearby(
+	ctx context.Context,
+	lat float64,
+	long float64,
+	distance float64,
+	timeWindow time.Duration,
+	opts ...ScheduleOption,
+) ([]NearbyClass, error) {
+	studios, err := c.ListStudiosAll(ctx, lat, long, distance)
+	if err != nil {
+		return nil, fmt.Errorf("error listing nearby studios: %w", err)
+	}
+	if len(studios) == 0 {
+		return nil, nil
+	}
+
+	studioIDs := make([]string, 0, len(studios))
+	distances := make(map[string]float64, len(studios))
+	for _, s := range studios {
+		studioIDs = append(studioIDs, s.StudioUUID)
+		distances[s.StudioUUID] = s.Distance
+	}
+
+	now := time.Now()
+	schedule, err := c.GetStudiosSchedulesRange(ctx, studioIDs, now, now.Add(timeWindow), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	classes := make([]NearbyClass, 0, len(schedule.Items))
+	for _, sc := range schedule.Items {
+		d, ok := distances[sc.Studio.ID]
+		if !ok {
+			d = Haversine(lat, long, sc.Studio.Latitude, sc.Studio.Longitude)
+		}
+		classes = append(classes, NearbyClass{StudioClass: sc, Distance: d})
+	}
+
+	sort.SliceStable(classes, func(i, j int) bool {
+		a, b := classes[i], classes[j]
+		if !a.StartsAt.Equal(b.StartsAt) {
+			return a.StartsAt.Before(b.StartsAt)
+		}
+		return a.Distance < b.Distance
+	})
+
+	return classes, nil
+}
@@ -0,0 +1,64 @@
+package otf_api
+
+import (
+	"context"
+	"net/http"
+)
+
+type NotificationSettings struct {
+	PromotionalSMS     bool `json:"isPromotionalSmsOptIn"`
+	TransactionalSMS   bool `json:"isTransactionalSmsOptIn"`
+	PromotionalEmail   bool `json:"isPromotionalEmailOptIn"`
+	TransactionalEmail bool `json:"isTransactionalEmailOptIn"`
+}
+
+type NotificationSettingsResponse struct {
+	Data NotificationSettings `json:"data"`
+}
+
+// GetNotificationSettings returns the member's SMS and email preferences.
+func (c *Client) GetNotificationSettings(ctx context.Context) (NotificationSettings, error) {
+	u, err := c.memberURL("notification-settings")
+	if err != nil {
+		return NotificationSettings{}, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return NotificationSettings{}, err
+	}
+
+	parsedResp := NotificationSettingsResponse{}
+	err = c.do(req, &parsedResp)
+	if err != nil {
+		return NotificationSettings{}, err
+	}
+
+	return parsedResp.Data, nil
+}
+
+// UpdateNotificationSettings replaces the member's SMS and email
+// preferences and returns the settings stored by the server. Fetch the
+// current settings first to change a single preference.
+func (c *Client) UpdateNotificationSettings(
+	ctx context.Context,
+	settings NotificationSettings,
+) (NotificationSettings, error) {
+	u, err := c.memberURL("notification-settings")
+	if err != nil {
+		return NotificationSettings{}, err
+	}
+
+	req, err := newJSONRequest(ctx, http.MethodPut, u, settings)
+	if err != nil {
+		return NotificationSettings{}, err
+	}
+
+	parsedResp := NotificationSettingsResponse{}
+	err = c.do(req, &parsedResp)
+	if err != nil {
+		return NotificationSettings{}, err
+	}
+
+	return parsedResp.Data, nil
+}
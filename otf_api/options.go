@@ -0,0 +1,124 @@
+package otf_api
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Option configures a Client created by NewClient.
+type Option func(*Client) error
+
+// WithBaseURLs overrides the orangetheory.io and orangetheory.co API base
+// URLs. Empty values keep the current setting.
+func WithBaseURLs(ioBaseURL string, coBaseURL string) Option {
+	return func(c *Client) error {
+		if ioBaseURL != "" {
+			c.BaseIOURL = ioBaseURL
+		}
+		if coBaseURL != "" {
+			c.BaseCOURL = coBaseURL
+		}
+		return nil
+	}
+}
+
+// WithTelemetryBaseURL overrides the workout telemetry API base URL.
+func WithTelemetryBaseURL(baseURL string) Option {
+	return func(c *Client) error {
+		if baseURL == "" {
+			return fmt.Errorf("telemetry base url must not be empty")
+		}
+
+		c.BaseTelemetryURL = baseURL
+		return nil
+	}
+}
+
+// WithAuthURL overrides the Cognito endpoint used to authenticate.
+func WithAuthURL(authURL string) Option {
+	return func(c *Client) error {
+		if authURL == "" {
+			return fmt.Errorf("auth url must not be empty")
+		}
+
+		c.AuthURL = authURL
+		return nil
+	}
+}
+
+// WithClientID sets the Cognito app client ID used to authenticate,
+// overriding OTF_CLIENT_ID and DefaultClientID.
+func WithClientID(clientID string) Option {
+	return func(c *Client) error {
+		if clientID == "" {
+			return fmt.Errorf("client id must not be empty")
+		}
+
+		c.ClientID = clientID
+		return nil
+	}
+}
+
+// WithTransport sets the base http.RoundTripper used for every request.
+// Middleware added by the client (e.g. authentication headers) is chained
+// on top of it.
+func WithTransport(rt http.RoundTripper) Option {
+	return func(c *Client) error {
+		if rt == nil {
+			return fmt.Errorf("transport must not be nil")
+		}
+
+		c.transport = rt
+		return nil
+	}
+}
+
+// WithProxy routes every request through the given proxy URL.
+func WithProxy(proxyURL *url.URL) Option {
+	return func(c *Client) error {
+		t, err := c.httpTransport()
+		if err != nil {
+			return err
+		}
+
+		t.Proxy = http.ProxyURL(proxyURL)
+		return nil
+	}
+}
+
+// WithTLSConfig sets the TLS configuration used by the underlying
+// transport, e.g. to trust a custom CA when intercepting traffic.
+func WithTLSConfig(cfg *tls.Config) Option {
+	return func(c *Client) error {
+		t, err := c.httpTransport()
+		if err != nil {
+			return err
+		}
+
+		t.TLSClientConfig = cfg
+		return nil
+	}
+}
+
+// httpTransport returns a private copy of the client's base transport as
+// an *http.Transport, starting from http.DefaultTransport when none has
+// been configured yet. Transports are cloned so that shared ones, such as
+// http.DefaultTransport itself, are never modified.
+func (c *Client) httpTransport() (*http.Transport, error) {
+	rt := c.transport
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+
+	t, ok := rt.(*http.Transport)
+	if !ok {
+		return nil, fmt.Errorf("transport %T is not an *http.Transport", rt)
+	}
+
+	t = t.Clone()
+	c.transport = t
+
+	return t, nil
+}
@@ -1,50 +1,241 @@
 package otf_api
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log"
 	"net/http"
+	"net/url"
 	"os"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/joho/godotenv"
 )
 
+// Production endpoints used unless overridden through the environment or
+// options.
+const (
+	DefaultIOBaseURL = "https://api.orangetheory.io/v1/"
+	DefaultCOBaseURL = "https://api.orangetheory.co/"
+	// DefaultTelemetryBaseURL serves workout telemetry.
+	DefaultTelemetryBaseURL = "https://api.yuzu.orangetheory.com/v1/"
+	DefaultAuthURL          = "https://cognito-idp.us-east-1.amazonaws.com/"
+
+	// DefaultClientID is the Cognito app client ID of the public OTF app.
+	DefaultClientID = "65knvqta6p37efc2l3eh26pl5o"
+)
+
 type Client struct {
-	BaseIOURL  string
-	BaseCOURL  string
-	AuthURL    string
-	Token      string
-	HTTPClient *http.Client
-	MemberID   string
+	BaseIOURL        string
+	BaseCOURL        string
+	BaseTelemetryURL string
+	AuthURL          string
+	ClientID         string
+	Token            string
+	HTTPClient       *http.Client
+	MemberID         string
+
+	transport   http.RoundTripper
+	middlewares []Middleware
+	credentials CredentialsProvider
+
+	authMu  sync.RWMutex
+	renewMu sync.Mutex
+	creds   Credentials
+	session AuthResult
+
+	tokenCache TokenCache
+	dryRun     bool
+
+	preferredStudios    []string
+	weeklyGoal          int
+	scheduleConcurrency int
+	scheduleChunkSize   int
+	scheduleCache       *scheduleCache
+
+	eventMu         sync.RWMutex
+	bookingHandlers []func(BookingEvent)
+
+	writeThrottle *bookingThrottle
 }
 
-func getEnvVar(key string) string {
+var loadDotEnv = sync.OnceValue(func() error {
 	err := godotenv.Load(".env")
-	if err != nil {
-		log.Fatal(err)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	return err
+})
+
+// getEnvVar returns the value of the environment variable, loading a .env
+// file from the working directory first if one exists.
+func getEnvVar(key string) string {
+	if err := loadDotEnv(); err != nil {
+		log.Printf("otf_api: error loading .env file: %v", err)
 	}
 
 	return os.Getenv(key)
 }
 
+// envOrDefault returns the environment variable's value, or def when it is
+// unset.
+func envOrDefault(key string, def string) string {
+	if v := getEnvVar(key); v != "" {
+		return v
+	}
+
+	return def
+}
+
 // NewClient constructor that creates and returns a new instance
-// of the OTF API client.
-func NewClient() (*Client, error) {
-	baseIOURL := getEnvVar("OTF_API_IO_BASE_URL")
-	baseCOURL := getEnvVar("OTF_API_CO_BASE_URL")
-	authURL := getEnvVar("OTF_AUTH_URL")
+// of the OTF API client. The production endpoints are used by default;
+// they can be overridden with the OTF_* environment variables or options.
+func NewClient(opts ...Option) (*Client, error) {
+	c := &Client{
+		BaseIOURL:        envOrDefault("OTF_API_IO_BASE_URL", DefaultIOBaseURL),
+		BaseCOURL:        envOrDefault("OTF_API_CO_BASE_URL", DefaultCOBaseURL),
+		BaseTelemetryURL: envOrDefault("OTF_API_TELEMETRY_BASE_URL", DefaultTelemetryBaseURL),
+		AuthURL:          envOrDefault("OTF_AUTH_URL", DefaultAuthURL),
+		ClientID:         envOrDefault("OTF_CLIENT_ID", DefaultClientID),
+		HTTPClient: &http.Client{
+			Timeout:       10 * time.Second,
+			CheckRedirect: checkRedirect,
+		},
+		writeThrottle: &bookingThrottle{
+			minInterval: DefaultBookingInterval,
+			cooldown:    DefaultBookingCooldown,
+		},
+	}
 
-	if baseIOURL == "" || baseCOURL == "" || authURL == "" {
+	for _, opt := range opts {
+		if err := opt(c); err != nil {
+			return nil, fmt.Errorf("error applying option: %w", err)
+		}
+	}
+
+	if c.BaseIOURL == "" || c.BaseCOURL == "" || c.AuthURL == "" {
 		return nil, fmt.Errorf("base urls not configured correctly")
 	}
+	c.BaseCOURL = normalizeCOBaseURL(c.BaseCOURL)
 
-	return &Client{
-		BaseIOURL: baseIOURL,
-		BaseCOURL: baseCOURL,
-		AuthURL:   authURL,
-		HTTPClient: &http.Client{
-			Timeout: 10 * time.Second,
-		},
-	}, nil
+	c.HTTPClient.Transport = c.roundTripper(c.authorize(), c.reauthenticate())
+
+	return c, nil
+}
+
+// legacyCOBasePath is the path older configurations included in the
+// orangetheory.co base URL, back when it only served the studios list.
+const legacyCOBasePath = "mobile/v1/"
+
+// normalizeCOBaseURL strips the legacy studios path from an orangetheory.co
+// base URL, since the studios endpoint now adds it itself and the other
+// endpoints on that host live outside of it.
+func normalizeCOBaseURL(base string) string {
+	withSlash := strings.TrimSuffix(base, "/") + "/"
+	if !strings.HasSuffix(withSlash, "/"+legacyCOBasePath) {
+		return base
+	}
+
+	trimmed := strings.TrimSuffix(withSlash, legacyCOBasePath)
+	log.Printf("otf_api: the orangetheory.co base url should not include %q anymore; using %s", legacyCOBasePath, trimmed)
+
+	return trimmed
+}
+
+// roundTripper chains the given middlewares on top of the base transport,
+// followed by any middleware registered through options so that
+// instrumentation observes the final request.
+func (c *Client) roundTripper(middlewares ...Middleware) http.RoundTripper {
+	return Chain(c.transport, append(middlewares, c.middlewares...)...)
+}
+
+// newJSONRequest prepares a request with body encoded as JSON.
+func newJSONRequest(
+	ctx context.Context,
+	method string,
+	url string,
+	body any,
+) (*http.Request, error) {
+	jsonBody, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed marshaling request body: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	return req, nil
+}
+
+// do sends the request and decodes the JSON response body into v. Non-2xx
+// responses are returned as an *APIError.
+func (c *Client) do(req *http.Request, v any) error {
+	return c.doStream(req, func(body io.Reader) error {
+		if v == nil {
+			return nil
+		}
+
+		return json.NewDecoder(body).Decode(v)
+	})
+}
+
+// doStream sends the request and hands the body of a 2xx response to
+// decode. Non-2xx responses are returned as an *APIError.
+func (c *Client) doStream(req *http.Request, decode func(io.Reader) error) error {
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return classifyNetworkError(err)
+	}
+	defer res.Body.Close()
+
+	if err := checkResponse(res); err != nil {
+		return err
+	}
+
+	err = decode(res.Body)
+	if err != nil {
+		return fmt.Errorf("error parsing response: %w", err)
+	}
+
+	return nil
+}
+
+// memberURL returns the URL of a member-scoped endpoint, e.g.
+// memberURL("bookings") for ".../member/members/{id}/bookings".
+func (c *Client) memberURL(elem ...string) (string, error) {
+	memberID, err := c.requireMemberID()
+	if err != nil {
+		return "", err
+	}
+
+	u := c.BaseCOURL + "member/members/" + url.PathEscape(memberID)
+	for _, e := range elem {
+		u += "/" + url.PathEscape(e)
+	}
+
+	return u, nil
+}
+
+// requireMemberID returns the member ID, falling back to the token claims,
+// or ErrMemberIDRequired when it is not known.
+func (c *Client) requireMemberID() (string, error) {
+	memberID, err := c.MemberUUID()
+	if errors.Is(err, ErrMemberIDRequired) {
+		return "", err
+	}
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrMemberIDRequired, err)
+	}
+
+	return memberID, nil
 }
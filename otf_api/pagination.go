@@ -0,0 +1,96 @@
+package otf_api
+
+import "context"
+
+// firstPageIndex is the index of the first page on paginated endpoints.
+const firstPageIndex = 1
+
+// PageFetcher fetches a single page of results for the given page index.
+type PageFetcher[T any] func(ctx context.Context, pageIndex int) ([]T, Pagination, error)
+
+// PageIterator walks every page of a paginated endpoint, fetching pages
+// lazily as items are consumed:
+//
+//	it := client.ListStudiosIterator(ctx, lat, long, distance)
+//	for it.Next() {
+//		studio := it.Item()
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type PageIterator[T any] struct {
+	ctx   context.Context
+	fetch PageFetcher[T]
+
+	items     []T
+	pos       int
+	nextPage  int
+	lastPage  bool
+	current   T
+	err       error
+	pageCount int
+}
+
+// NewPageIterator returns an iterator that uses fetch to retrieve pages.
+func NewPageIterator[T any](ctx context.Context, fetch PageFetcher[T]) *PageIterator[T] {
+	return &PageIterator[T]{
+		ctx:      ctx,
+		fetch:    fetch,
+		nextPage: firstPageIndex,
+	}
+}
+
+// Next advances the iterator to the next item, fetching the next page when
+// the current one is exhausted. It returns false when there are no more
+// items or an error occurred.
+func (it *PageIterator[T]) Next() bool {
+	for it.pos >= len(it.items) {
+		if it.err != nil || it.lastPage {
+			return false
+		}
+
+		items, pagination, err := it.fetch(it.ctx, it.nextPage)
+		if err != nil {
+			it.err = err
+			return false
+		}
+
+		it.items = items
+		it.pos = 0
+		it.pageCount++
+		// Pages may be empty after client-side filtering, so only the
+		// page count decides when to stop.
+		it.lastPage = it.nextPage >= pagination.TotalPages
+		it.nextPage++
+	}
+
+	it.current = it.items[it.pos]
+	it.pos++
+
+	return true
+}
+
+// Item returns the item the iterator currently points at.
+func (it *PageIterator[T]) Item() T {
+	return it.current
+}
+
+// Err returns the first error encountered while fetching pages.
+func (it *PageIterator[T]) Err() error {
+	return it.err
+}
+
+// PagesFetched returns how many pages have been fetched so far.
+func (it *PageIterator[T]) PagesFetched() int {
+	return it.pageCount
+}
+
+// Collect drains the iterator and returns every remaining item.
+func (it *PageIterator[T]) Collect() ([]T, error) {
+	var all []T
+	for it.Next() {
+		all = append(all, it.Item())
+	}
+
+	return all, it.Err()
+}
@@ -0,0 +1,181 @@
+package otf_api
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	LimitQueryParamKey  = "limit"
+	BeforeQueryParamKey = "before"
+
+	// memberIDHeader identifies the member on performance endpoints.
+	memberIDHeader = "koji-member-id"
+)
+
+type ZoneTimeMinutes struct {
+	Gray   float64 `json:"gray"`
+	Blue   float64 `json:"blue"`
+	Green  float64 `json:"green"`
+	Orange float64 `json:"orange"`
+	Red    float64 `json:"red"`
+}
+
+type HeartRateSummary struct {
+	AverageHR int `json:"avg_hr"`
+	MaxHR     int `json:"max_hr"`
+	PeakHR    int `json:"peak_hr"`
+}
+
+type PerformanceSummaryCoach struct {
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+}
+
+type PerformanceSummaryClass struct {
+	ClassUUID string                  `json:"class_uuid"`
+	Name      string                  `json:"name"`
+	StartsAt  time.Time               `json:"starts_at"`
+	Coach     PerformanceSummaryCoach `json:"coach"`
+	Studio    StudioClassStudio       `json:"studio"`
+}
+
+type PerformanceSummaryDetails struct {
+	CaloriesBurned  int              `json:"calories_burned"`
+	SplatPoints     int              `json:"splat_points"`
+	HeartRate       HeartRateSummary `json:"heart_rate"`
+	ZoneTimeMinutes ZoneTimeMinutes  `json:"zone_time_minutes"`
+}
+
+type PerformanceSummary struct {
+	ID      string                    `json:"id"`
+	Class   PerformanceSummaryClass   `json:"class"`
+	Details PerformanceSummaryDetails `json:"details"`
+	Ratable bool                      `json:"ratable"`
+}
+
+type PerformanceSummariesResponse struct {
+	Items []PerformanceSummary `json:"items"`
+}
+
+// GetPerformanceSummaries lists past workouts, most recent first. limit
+// caps the number of workouts returned and before, when non-zero, only
+// returns workouts that started before that time.
+func (c *Client) GetPerformanceSummaries(
+	ctx context.Context,
+	limit int,
+	before time.Time,
+) ([]PerformanceSummary, error) {
+	params := url.Values{}
+	if limit > 0 {
+		params.Set(LimitQueryParamKey, strconv.Itoa(limit))
+	}
+	if !before.IsZero() {
+		params.Set(BeforeQueryParamKey, before.UTC().Format(time.RFC3339))
+	}
+
+	u := c.BaseCOURL + "v1/performance-summaries?" + params.Encode()
+	req, err := c.newPerformanceRequest(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedResp := PerformanceSummariesResponse{}
+	err = c.do(req, &parsedResp)
+	if err != nil {
+		return nil, err
+	}
+
+	return parsedResp.Items, nil
+}
+
+// newPerformanceRequest prepares a GET request to a performance endpoint,
+// which identifies the member through a header rather than the path.
+func (c *Client) newPerformanceRequest(ctx context.Context, u string) (*http.Request, error) {
+	memberID, err := c.requireMemberID()
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set(memberIDHeader, memberID)
+
+	return req, nil
+}
+
+// Measurement is a value with the unit it is displayed in, e.g. miles or
+// meters depending on the member's settings.
+type Measurement struct {
+	Value float64 `json:"display_value"`
+	Unit  string  `json:"display_unit"`
+}
+
+type TreadmillData struct {
+	TotalDistance   Measurement `json:"total_distance"`
+	ElevationGained Measurement `json:"elevation_gained"`
+	AverageSpeed    Measurement `json:"avg_speed"`
+	MaxSpeed        Measurement `json:"max_speed"`
+	AverageIncline  Measurement `json:"avg_incline"`
+	MaxIncline      Measurement `json:"max_incline"`
+	AveragePace     Measurement `json:"avg_pace"`
+	MaxPace         Measurement `json:"max_pace"`
+	MovingTime      Measurement `json:"moving_time"`
+}
+
+type RowerData struct {
+	TotalDistance     Measurement `json:"total_distance"`
+	AverageWatts      Measurement `json:"avg_power"`
+	MaxWatts          Measurement `json:"max_power"`
+	AverageSplit      Measurement `json:"avg_pace"`
+	MaxSplit          Measurement `json:"max_pace"`
+	AverageStrokeRate Measurement `json:"avg_cadence"`
+	MaxStrokeRate     Measurement `json:"max_cadence"`
+	AverageSpeed      Measurement `json:"avg_speed"`
+	MovingTime        Measurement `json:"moving_time"`
+}
+
+type EquipmentData struct {
+	Treadmill *TreadmillData `json:"treadmill"`
+	Rower     *RowerData     `json:"rower"`
+}
+
+type PerformanceDetails struct {
+	PerformanceSummaryDetails
+	StepCount int           `json:"step_count"`
+	Equipment EquipmentData `json:"equipment_data"`
+}
+
+type PerformanceSummaryDetail struct {
+	ID      string                  `json:"id"`
+	Class   PerformanceSummaryClass `json:"class"`
+	Details PerformanceDetails      `json:"details"`
+	Ratable bool                    `json:"ratable"`
+}
+
+// GetPerformanceSummaryDetail returns the full detail of a single workout:
+// time per heart-rate zone, treadmill and rower totals and step count.
+// Equipment that was not used in the workout is nil.
+func (c *Client) GetPerformanceSummaryDetail(
+	ctx context.Context,
+	performanceSummaryID string,
+) (PerformanceSummaryDetail, error) {
+	u := c.BaseCOURL + "v1/performance-summaries/" + url.PathEscape(performanceSummaryID)
+	req, err := c.newPerformanceRequest(ctx, u)
+	if err != nil {
+		return PerformanceSummaryDetail{}, err
+	}
+
+	parsedResp := PerformanceSummaryDetail{}
+	err = c.do(req, &parsedResp)
+	if err != nil {
+		return PerformanceSummaryDetail{}, err
+	}
+
+	return parsedResp, nil
+}
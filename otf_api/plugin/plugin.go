@@ -0,0 +1,101 @@
+// Package plugin provides compile-time registries for community
+// extensions such as exporters, notifiers and output formats.
+//
+// Extensions register themselves from an init function, so enabling one
+// only takes a blank import:
+//
+//	import _ "example.com/otf-intervals-exporter"
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+)
+
+// Exporter writes data (e.g. workouts) to an external format.
+type Exporter interface {
+	Export(ctx context.Context, w io.Writer, data any) error
+}
+
+// Notifier delivers a message to the user through some channel.
+type Notifier interface {
+	Notify(ctx context.Context, subject string, body string) error
+}
+
+// Formatter renders data for display, e.g. as a table or JSON.
+type Formatter interface {
+	Format(w io.Writer, data any) error
+}
+
+// Factory creates an extension from its configuration.
+type Factory[T any] func(config map[string]string) (T, error)
+
+// Registry holds named extension factories of one kind. It is safe for
+// concurrent use.
+type Registry[T any] struct {
+	kind      string
+	mu        sync.RWMutex
+	factories map[string]Factory[T]
+}
+
+// NewRegistry returns an empty registry; kind is used in error messages.
+func NewRegistry[T any](kind string) *Registry[T] {
+	return &Registry[T]{
+		kind:      kind,
+		factories: make(map[string]Factory[T]),
+	}
+}
+
+// Register adds a factory under name. It panics if name is empty, the
+// factory is nil or the name is already registered, mirroring
+// database/sql.Register.
+func (r *Registry[T]) Register(name string, factory Factory[T]) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if name == "" || factory == nil {
+		panic(fmt.Sprintf("plugin: invalid %s registration %q", r.kind, name))
+	}
+	if _, dup := r.factories[name]; dup {
+		panic(fmt.Sprintf("plugin: %s %q registered twice", r.kind, name))
+	}
+
+	r.factories[name] = factory
+}
+
+// New creates the extension registered under name.
+func (r *Registry[T]) New(name string, config map[string]string) (T, error) {
+	r.mu.RLock()
+	factory, ok := r.factories[name]
+	r.mu.RUnlock()
+
+	if !ok {
+		var zero T
+		return zero, fmt.Errorf("plugin: unknown %s %q", r.kind, name)
+	}
+
+	return factory(config)
+}
+
+// Names returns the registered names in sorted order.
+func (r *Registry[T]) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, 0, len(r.factories))
+	for name := range r.factories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+var (
+	Exporters  = NewRegistry[Exporter]("exporter")
+	Notifiers  = NewRegistry[Notifier]("notifier")
+	Formatters = NewRegistry[Formatter]("formatter")
+)
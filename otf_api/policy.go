@@ -0,0 +1,64 @@
+package otf_api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// CancellationPolicy is a studio's late cancellation policy.
+type CancellationPolicy struct {
+	// LateCancelHours is how many hours before class start a
+	// cancellation counts as late.
+	LateCancelHours float64 `json:"lateCancelHours"`
+	LateCancelFee   float64 `json:"lateCancelFee"`
+	NoShowFee       float64 `json:"noShowFee"`
+	Currency        string  `json:"currency"`
+}
+
+// Window returns the late cancellation window.
+func (p CancellationPolicy) Window() time.Duration {
+	return time.Duration(p.LateCancelHours * float64(time.Hour))
+}
+
+type CancellationPolicyResponse struct {
+	Data CancellationPolicy `json:"data"`
+}
+
+// defaultCancellationPolicy applies to studios without a published policy.
+var defaultCancellationPolicy = CancellationPolicy{
+	LateCancelHours: DefaultLateCancelWindow.Hours(),
+}
+
+// GetCancellationPolicy returns the studio's late cancellation cutoff and
+// fees. Studios that publish no policy, or one without a late cancel
+// window, get the standard 8 hour window.
+func (c *Client) GetCancellationPolicy(
+	ctx context.Context,
+	studioUUID string,
+) (CancellationPolicy, error) {
+	u := c.BaseCOURL + "mobile/v1/studios/" + url.PathEscape(studioUUID) + "/cancellation-policy"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return CancellationPolicy{}, err
+	}
+
+	parsedResp := CancellationPolicyResponse{}
+	err = c.do(req, &parsedResp)
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+		return defaultCancellationPolicy, nil
+	}
+	if err != nil {
+		return CancellationPolicy{}, err
+	}
+
+	policy := parsedResp.Data
+	if policy.LateCancelHours <= 0 {
+		policy.LateCancelHours = defaultCancellationPolicy.LateCancelHours
+	}
+
+	return policy, nil
+}
@@ -0,0 +1,75 @@
+package otf_api
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+type Purchase struct {
+	PurchaseUUID  string  `json:"purchaseUUId"`
+	Item          string  `json:"name"`
+	Status        string  `json:"status"`
+	Quantity      int     `json:"quantity"`
+	Amount        float64 `json:"price"`
+	Currency      string  `json:"currency"`
+	PaymentMethod string  `json:"paymentMethod"`
+	Date          Date    `json:"purchaseDateTime"`
+	Studio        Studio  `json:"studio"`
+}
+
+type PurchasesResponse struct {
+	Data []Purchase `json:"data"`
+}
+
+// GetPurchases returns the member's purchase and payment history.
+func (c *Client) GetPurchases(ctx context.Context) ([]Purchase, error) {
+	u, err := c.memberURL("purchases")
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedResp := PurchasesResponse{}
+	err = c.do(req, &parsedResp)
+	if err != nil {
+		return nil, err
+	}
+
+	return parsedResp.Data, nil
+}
+
+// WritePurchasesCSV writes purchases as CSV with a header row, suitable for
+// exporting receipts to a spreadsheet.
+func WritePurchasesCSV(w io.Writer, purchases []Purchase) error {
+	cw := csv.NewWriter(w)
+
+	err := cw.Write([]string{"date", "item", "studio", "quantity", "amount", "currency", "status"})
+	if err != nil {
+		return err
+	}
+
+	for _, p := range purchases {
+		err = cw.Write([]string{
+			p.Date.Format(dateLayout),
+			p.Item,
+			p.Studio.StudioName,
+			strconv.Itoa(p.Quantity),
+			strconv.FormatFloat(p.Amount, 'f', 2, 64),
+			p.Currency,
+			p.Status,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
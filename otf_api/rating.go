@@ -0,0 +1,78 @@
+package otf_api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Rating is a post-class rating as offered by the app.
+type Rating int
+
+const (
+	RatingDisliked Rating = 1
+	RatingNeutral  Rating = 2
+	RatingLiked    Rating = 3
+)
+
+func (r Rating) valid() bool {
+	return r >= RatingDisliked && r <= RatingLiked
+}
+
+type RateClassRequest struct {
+	ClassUUID            string `json:"classUUId"`
+	PerformanceSummaryID string `json:"otBeatClassHistoryUUId"`
+	ClassRating          Rating `json:"classRating"`
+	CoachRating          Rating `json:"coachRating"`
+}
+
+// RateClass submits the class and coach rating for a workout, matching the
+// app's post-class rating flow. Only workouts whose summary is Ratable
+// accept a rating.
+func (c *Client) RateClass(
+	ctx context.Context,
+	performanceSummaryID string,
+	classRating Rating,
+	coachRating Rating,
+) error {
+	if !classRating.valid() || !coachRating.valid() {
+		return fmt.Errorf("ratings must be between %d and %d", RatingDisliked, RatingLiked)
+	}
+
+	summary, err := c.GetPerformanceSummaryDetail(ctx, performanceSummaryID)
+	if err != nil {
+		return fmt.Errorf("error looking up workout: %w", err)
+	}
+	if !summary.Ratable {
+		return fmt.Errorf("workout %s cannot be rated", performanceSummaryID)
+	}
+
+	req, err := newJSONRequest(
+		ctx,
+		http.MethodPost,
+		c.BaseCOURL+"mobile/v1/members/classes/ratings",
+		RateClassRequest{
+			ClassUUID:            summary.Class.ClassUUID,
+			PerformanceSummaryID: performanceSummaryID,
+			ClassRating:          classRating,
+			CoachRating:          coachRating,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return c.do(req, nil)
+}
+
+// RatableWorkouts returns the workouts that can still be rated.
+func RatableWorkouts(summaries []PerformanceSummary) []PerformanceSummary {
+	var ratable []PerformanceSummary
+	for _, s := range summaries {
+		if s.Ratable {
+			ratable = append(ratable, s)
+		}
+	}
+
+	return ratable
+}
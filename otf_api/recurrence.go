@@ -0,0 +1,103 @@
+package otf_api
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+// RecurringClass is a class held at the same studio-local time by the same
+// coach on one or more weekdays.
+type RecurringClass struct {
+	Name       string
+	Coach      string
+	StudioID   string
+	StudioName string
+	// Hour and Minute are the studio-local start time.
+	Hour   int
+	Minute int
+	// Weekdays are ordered Monday first.
+	Weekdays []time.Weekday
+	Classes  []StudioClass
+}
+
+// String describes the pattern, e.g.
+// "Tread 50 with Alex, Mon/Wed/Fri 6:00 AM at Midtown".
+func (rc RecurringClass) String() string {
+	days := make([]string, len(rc.Weekdays))
+	for i, d := range rc.Weekdays {
+		days[i] = d.String()[:3]
+	}
+
+	s := rc.Name
+	if rc.Coach != "" {
+		s += " with " + rc.Coach
+	}
+	at := time.Date(0, 1, 1, rc.Hour, rc.Minute, 0, 0, time.UTC).Format(time.Kitchen)
+	s += fmt.Sprintf(", %s %s %s", strings.Join(days, "/"), at[:len(at)-2], at[len(at)-2:])
+	if rc.StudioName != "" {
+		s += " at " + rc.StudioName
+	}
+
+	return s
+}
+
+// DetectRecurring collapses classes into recurrence patterns, grouping by
+// class name, coach, studio and studio-local start time. Patterns are
+// ordered by start time, then name.
+func DetectRecurring(classes []StudioClass) []RecurringClass {
+	var patterns []RecurringClass
+	index := make(map[string]int)
+
+	for _, sc := range classes {
+		start := sc.StartsAtLocal()
+		key := strings.Join([]string{
+			sc.Name,
+			sc.Coach.Name(),
+			sc.Studio.ID,
+			start.Format("15:04"),
+		}, "\x00")
+
+		i, ok := index[key]
+		if !ok {
+			i = len(patterns)
+			index[key] = i
+			patterns = append(patterns, RecurringClass{
+				Name:       sc.Name,
+				Coach:      sc.Coach.FirstName,
+				StudioID:   sc.Studio.ID,
+				StudioName: sc.Studio.Name,
+				Hour:       start.Hour(),
+				Minute:     start.Minute(),
+			})
+		}
+
+		rc := &patterns[i]
+		if !slices.Contains(rc.Weekdays, start.Weekday()) {
+			rc.Weekdays = append(rc.Weekdays, start.Weekday())
+		}
+		rc.Classes = append(rc.Classes, sc)
+	}
+
+	for i := range patterns {
+		slices.SortFunc(patterns[i].Weekdays, func(a, b time.Weekday) int {
+			return mondayFirst(a) - mondayFirst(b)
+		})
+	}
+
+	sort.SliceStable(patterns, func(i, j int) bool {
+		a, b := patterns[i], patterns[j]
+		if a.Hour*60+a.Minute != b.Hour*60+b.Minute {
+			return a.Hour*60+a.Minute < b.Hour*60+b.Minute
+		}
+		return a.Name < b.Name
+	})
+
+	return patterns
+}
+
+func mondayFirst(d time.Weekday) int {
+	return (int(d) + 6) % 7
+}
@@ -0,0 +1,43 @@
+package otf_api
+
+import (
+	"errors"
+	"net/http"
+)
+
+// maxRedirects is the number of redirects followed before giving up.
+const maxRedirects = 10
+
+// sensitiveHeaders are stripped from redirected requests that leave the
+// original host.
+var sensitiveHeaders = []string{
+	"Authorization",
+	"Cookie",
+	"Proxy-Authorization",
+}
+
+// checkRedirect limits the number of redirects and never forwards
+// credentials to a different host or over a downgraded scheme.
+func checkRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= maxRedirects {
+		return errors.New("stopped after 10 redirects")
+	}
+
+	orig := via[0].URL
+	if req.URL.Host != orig.Host || (orig.Scheme == "https" && req.URL.Scheme != "https") {
+		for _, h := range sensitiveHeaders {
+			req.Header.Del(h)
+		}
+	}
+
+	return nil
+}
+
+// WithCookieJar enables cookie persistence across requests. Cookies are
+// not stored unless this option is used.
+func WithCookieJar(jar http.CookieJar) Option {
+	return func(c *Client) error {
+		c.HTTPClient.Jar = jar
+		return nil
+	}
+}
@@ -0,0 +1,80 @@
+package otf_api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/mail"
+)
+
+type Referral struct {
+	ReferralUUID string `json:"referralUUId"`
+	Email        string `json:"email"`
+	FirstName    string `json:"firstName"`
+	LastName     string `json:"lastName"`
+	Status       string `json:"status"`
+	CreatedDate  Date   `json:"createdDate"`
+	ExpiresDate  Date   `json:"guestPassExpirationDate"`
+}
+
+type ReferralsResponse struct {
+	Data []Referral `json:"data"`
+}
+
+type ReferralResponse struct {
+	Data Referral `json:"data"`
+}
+
+type CreateReferralRequest struct {
+	Email string `json:"email"`
+}
+
+// GetReferrals returns the guest passes the member has sent and their
+// current status.
+func (c *Client) GetReferrals(ctx context.Context) ([]Referral, error) {
+	u, err := c.memberURL("referrals")
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedResp := ReferralsResponse{}
+	err = c.do(req, &parsedResp)
+	if err != nil {
+		return nil, err
+	}
+
+	return parsedResp.Data, nil
+}
+
+// CreateReferral sends a guest pass to the given email address.
+func (c *Client) CreateReferral(ctx context.Context, email string) (Referral, error) {
+	addr, err := mail.ParseAddress(email)
+	if err != nil {
+		return Referral{}, fmt.Errorf("invalid email address %q: %w", email, err)
+	}
+
+	u, err := c.memberURL("referrals")
+	if err != nil {
+		return Referral{}, err
+	}
+
+	req, err := newJSONRequest(ctx, http.MethodPost, u, CreateReferralRequest{
+		Email: addr.Address,
+	})
+	if err != nil {
+		return Referral{}, err
+	}
+
+	parsedResp := ReferralResponse{}
+	err = c.do(req, &parsedResp)
+	if err != nil {
+		return Referral{}, asValidationError(err)
+	}
+
+	return parsedResp.Data, nil
+}
@@ -0,0 +1,149 @@
+package otf_api
+
+import "strings"
+
+// countryCodes maps the country names and ISO 3166-1 alpha-3 codes seen in
+// OTF studio addresses to alpha-2 codes.
+var countryCodes = map[string]string{
+	"UNITED STATES":            "US",
+	"UNITED STATES OF AMERICA": "US",
+	"USA":                      "US",
+	"CANADA":                   "CA",
+	"CAN":                      "CA",
+	"MEXICO":                   "MX",
+	"MEX":                      "MX",
+	"UNITED KINGDOM":           "GB",
+	"GBR":                      "GB",
+	"UK":                       "GB",
+	"AUSTRALIA":                "AU",
+	"AUS":                      "AU",
+	"NEW ZEALAND":              "NZ",
+	"NZL":                      "NZ",
+	"CHILE":                    "CL",
+	"CHL":                      "CL",
+	"COLOMBIA":                 "CO",
+	"COL":                      "CO",
+	"PERU":                     "PE",
+	"PER":                      "PE",
+	"GERMANY":                  "DE",
+	"DEU":                      "DE",
+	"SPAIN":                    "ES",
+	"ESP":                      "ES",
+	"SWITZERLAND":              "CH",
+	"CHE":                      "CH",
+	"JAPAN":                    "JP",
+	"JPN":                      "JP",
+	"HONG KONG":                "HK",
+	"HKG":                      "HK",
+	"SINGAPORE":                "SG",
+	"SGP":                      "SG",
+	"UNITED ARAB EMIRATES":     "AE",
+	"ARE":                      "AE",
+	"SAUDI ARABIA":             "SA",
+	"SAU":                      "SA",
+	"KUWAIT":                   "KW",
+	"KWT":                      "KW",
+	"DOMINICAN REPUBLIC":       "DO",
+	"DOM":                      "DO",
+	"GUATEMALA":                "GT",
+	"GTM":                      "GT",
+	"PUERTO RICO":              "PR",
+	"PRI":                      "PR",
+}
+
+// subdivisionCodes maps US state and Canadian province names to their
+// ISO 3166-2 subdivision codes, without the country prefix.
+var subdivisionCodes = map[string]string{
+	"ALABAMA":              "AL",
+	"ALASKA":               "AK",
+	"ARIZONA":              "AZ",
+	"ARKANSAS":             "AR",
+	"CALIFORNIA":           "CA",
+	"COLORADO":             "CO",
+	"CONNECTICUT":          "CT",
+	"DELAWARE":             "DE",
+	"DISTRICT OF COLUMBIA": "DC",
+	"FLORIDA":              "FL",
+	"GEORGIA":              "GA",
+	"HAWAII":               "HI",
+	"IDAHO":                "ID",
+	"ILLINOIS":             "IL",
+	"INDIANA":              "IN",
+	"IOWA":                 "IA",
+	"KANSAS":               "KS",
+	"KENTUCKY":             "KY",
+	"LOUISIANA":            "LA",
+	"MAINE":                "ME",
+	"MARYLAND":             "MD",
+	"MASSACHUSETTS":        "MA",
+	"MICHIGAN":             "MI",
+	"MINNESOTA":            "MN",
+	"MISSISSIPPI":          "MS",
+	"MISSOURI":             "MO",
+	"MONTANA":              "MT",
+	"NEBRASKA":             "NE",
+	"NEVADA":               "NV",
+	"NEW HAMPSHIRE":        "NH",
+	"NEW JERSEY":           "NJ",
+	"NEW MEXICO":           "NM",
+	"NEW YORK":             "NY",
+	"NORTH CAROLINA":       "NC",
+	"NORTH DAKOTA":         "ND",
+	"OHIO":                 "OH",
+	"OKLAHOMA":             "OK",
+	"OREGON":               "OR",
+	"PENNSYLVANIA":         "PA",
+	"RHODE ISLAND":         "RI",
+	"SOUTH CAROLINA":       "SC",
+	"SOUTH DAKOTA":         "SD",
+	"TENNESSEE":            "TN",
+	"TEXAS":                "TX",
+	"UTAH":                 "UT",
+	"VERMONT":              "VT",
+	"VIRGINIA":             "VA",
+	"WASHINGTON":           "WA",
+	"WEST VIRGINIA":        "WV",
+	"WISCONSIN":            "WI",
+	"WYOMING":              "WY",
+
+	"ALBERTA":                   "AB",
+	"BRITISH COLUMBIA":          "BC",
+	"MANITOBA":                  "MB",
+	"NEW BRUNSWICK":             "NB",
+	"NEWFOUNDLAND AND LABRADOR": "NL",
+	"NOVA SCOTIA":               "NS",
+	"NORTHWEST TERRITORIES":     "NT",
+	"NUNAVUT":                   "NU",
+	"ONTARIO":                   "ON",
+	"PRINCE EDWARD ISLAND":      "PE",
+	"QUEBEC":                    "QC",
+	"QUÉBEC":                    "QC",
+	"SASKATCHEWAN":              "SK",
+	"YUKON":                     "YT",
+}
+
+// countryCode normalizes a country name or code to ISO 3166-1 alpha-2.
+// Unknown values are returned upper-cased.
+func countryCode(country string) string {
+	country = strings.ToUpper(strings.TrimSpace(country))
+	if code, ok := countryCodes[country]; ok {
+		return code
+	}
+
+	return country
+}
+
+// subdivisionCode normalizes a state or province name or code to its ISO
+// 3166-2 code without the country prefix, e.g. "California", "CA" and
+// "US-CA" all become "CA". Unknown values are returned upper-cased.
+func subdivisionCode(state string) string {
+	state = strings.ToUpper(strings.TrimSpace(state))
+	if _, code, ok := strings.Cut(state, "-"); ok && len(code) <= 3 {
+		return code
+	}
+	if code, ok := subdivisionCodes[state]; ok {
+		return code
+	}
+
+	return state
+}
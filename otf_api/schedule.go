@@ -2,15 +2,20 @@ package otf_api
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
+	"sort"
+	"strings"
+	"sync"
 	"time"
+
+	"golang.org/x/sync/errgroup"
 )
 
 const (
 	StudioIDsQueryParamKey = "studio_ids"
+	PageTokenQueryParamKey = "page_token"
 )
 
 type StudioClassStudioAddress struct {
@@ -28,6 +33,40 @@ type StudioClassStudio struct {
 	Latitude    float64                  `json:"latitude"`
 	Longitude   float64                  `json:"longitude"`
 	Address     StudioClassStudioAddress `json:"address"`
+	// TimeZone is the studio's IANA time zone, e.g. "America/New_York".
+	TimeZone string `json:"time_zone"`
+}
+
+// studioLocations caches loaded time zones by name.
+var studioLocations sync.Map
+
+// Location returns the studio's time zone, or UTC when it is unknown.
+func (s StudioClassStudio) Location() *time.Location {
+	if s.TimeZone == "" {
+		return time.UTC
+	}
+	if loc, ok := studioLocations.Load(s.TimeZone); ok {
+		return loc.(*time.Location)
+	}
+
+	loc, err := time.LoadLocation(s.TimeZone)
+	if err != nil {
+		loc = time.UTC
+	}
+	studioLocations.Store(s.TimeZone, loc)
+
+	return loc
+}
+
+type StudioClassCoach struct {
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	ImageURL  string `json:"image_url"`
+}
+
+// Name returns the coach's full name.
+func (cc StudioClassCoach) Name() string {
+	return strings.TrimSpace(cc.FirstName + " " + cc.LastName)
 }
 
 type StudioClass struct {
@@ -41,10 +80,30 @@ type StudioClass struct {
 	WaitlistAvailable bool              `json:"waitlist_available"`
 	Canceled          bool              `json:"canceled"`
 	Studio            StudioClassStudio `json:"studio"`
+	Coach             StudioClassCoach  `json:"coach"`
+	// Virtual is set for OTF Live classes, which are not tied to a studio.
+	Virtual bool `json:"is_virtual"`
+	// BookingOpensAt and BookingClosesAt bound when the class can be
+	// booked, e.g. 30 days ahead for premier members; either may be zero
+	// when the API does not report it.
+	BookingOpensAt  time.Time `json:"booking_opens_at"`
+	BookingClosesAt time.Time `json:"booking_closes_at"`
+}
+
+// StartsAtLocal returns the start time in the studio's time zone.
+func (sc StudioClass) StartsAtLocal() time.Time {
+	return sc.StartsAt.In(sc.Studio.Location())
+}
+
+// EndsAtLocal returns the end time in the studio's time zone.
+func (sc StudioClass) EndsAtLocal() time.Time {
+	return sc.EndsAt.In(sc.Studio.Location())
 }
 
 type StudioScheduleResponse struct {
 	Items []StudioClass `json:"items"`
+	// NextPageToken is set when more classes are available.
+	NextPageToken string `json:"next_page_token,omitempty"`
 }
 
 type FilterValues struct {
@@ -61,39 +120,266 @@ type FilterItem struct {
 }
 
 type ClassTypeFiltersResponse struct {
-	Items []FilterItem
+	Items []FilterItem `json:"items"`
+}
+
+// Filter returns the filter with the given name, e.g. "class_type".
+func (r ClassTypeFiltersResponse) Filter(name string) (FilterItem, bool) {
+	for _, item := range r.Items {
+		if item.Name == name {
+			return item, true
+		}
+	}
+
+	return FilterItem{}, false
 }
 
-// GetStudiosSchedules
+// ClassTypes returns the available class types. Their values can be
+// passed to WithClassTypes.
+func (r ClassTypeFiltersResponse) ClassTypes() []ClassType {
+	item, ok := r.Filter(ClassTypeQueryParamKey)
+	if !ok {
+		return nil
+	}
+
+	types := make([]ClassType, 0, len(item.Values))
+	for _, v := range item.Values {
+		types = append(types, ClassType(v.Value))
+	}
+
+	return types
+}
+
+// GetStudiosSchedules returns the classes scheduled at the given studios,
+// optionally narrowed by schedule options such as WithClassTypes. Large
+// studio sets are split into chunks (see WithScheduleChunkSize) that are
+// fetched in parallel, 4 at a time unless WithScheduleConcurrency is set;
+// with WithScheduleConcurrency each studio gets its own request. Results
+// from several requests are merged ordered by start time.
 func (c *Client) GetStudiosSchedules(
 	ctx context.Context,
 	studioIDs []string,
+	opts ...ScheduleOption,
 ) (StudioScheduleResponse, error) {
-	params := url.Values{
-		StudioIDsQueryParamKey: studioIDs,
+	q := newScheduleQuery(studioIDs, opts)
+	if q.homeStudioOnly {
+		home, err := c.HomeStudioUUID(ctx)
+		if err != nil {
+			return StudioScheduleResponse{}, err
+		}
+		q.homeStudioUUID = home
 	}
 
-	url := c.BaseIOURL + "classes?" + params.Encode()
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	cacheKey := q.cacheKey()
+	if cached, ok := c.scheduleCache.get(cacheKey); ok {
+		return cached, nil
+	}
+
+	var (
+		parsedResp StudioScheduleResponse
+		err        error
+	)
+	if chunks := c.studioChunks(studioIDs); len(chunks) > 1 {
+		parsedResp, err = c.getSchedulesConcurrently(ctx, chunks, q)
+	} else {
+		parsedResp, err = c.getSchedules(ctx, q.params)
+	}
 	if err != nil {
 		return StudioScheduleResponse{}, err
 	}
 
-	res, err := c.HTTPClient.Do(req)
+	parsedResp.Items = q.filter(parsedResp.Items)
+	c.scheduleCache.set(cacheKey, parsedResp)
+
+	return parsedResp, nil
+}
+
+// getSchedules fetches every page of a classes query.
+func (c *Client) getSchedules(
+	ctx context.Context,
+	params url.Values,
+) (StudioScheduleResponse, error) {
+	all := StudioScheduleResponse{}
+
+	pageToken := ""
+	for {
+		page, err := c.getSchedulesPage(ctx, params, pageToken)
+		if err != nil {
+			return StudioScheduleResponse{}, err
+		}
+
+		all.Items = append(all.Items, page.Items...)
+		if page.NextPageToken == "" || page.NextPageToken == pageToken {
+			return all, nil
+		}
+		pageToken = page.NextPageToken
+	}
+}
+
+// getSchedulesPage performs a single classes request.
+func (c *Client) getSchedulesPage(
+	ctx context.Context,
+	params url.Values,
+	pageToken string,
+) (StudioScheduleResponse, error) {
+	if pageToken != "" {
+		params = cloneValues(params)
+		params.Set(PageTokenQueryParamKey, pageToken)
+	}
+
+	url := c.BaseIOURL + "classes?" + params.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return StudioScheduleResponse{}, err
 	}
-	defer res.Body.Close()
 
 	parsedResp := StudioScheduleResponse{}
-	err = json.NewDecoder(res.Body).Decode(&parsedResp)
+	err = c.do(req, &parsedResp)
 	if err != nil {
-		return StudioScheduleResponse{}, fmt.Errorf("error parsing response: %w", err)
+		return StudioScheduleResponse{}, err
 	}
 
 	return parsedResp, nil
 }
 
+// StudiosSchedulesIterator streams the classes at the given studios page
+// by page, so callers can process large date ranges without holding the
+// whole schedule in memory.
+func (c *Client) StudiosSchedulesIterator(
+	ctx context.Context,
+	studioIDs []string,
+	opts ...ScheduleOption,
+) *PageIterator[StudioClass] {
+	q := newScheduleQuery(studioIDs, opts)
+	pageToken := ""
+
+	return NewPageIterator(ctx, func(ctx context.Context, pageIndex int) ([]StudioClass, Pagination, error) {
+		page, err := c.getSchedulesPage(ctx, q.params, pageToken)
+		if err != nil {
+			return nil, Pagination{}, err
+		}
+
+		// Translate the continuation token into the page count the
+		// iterator expects: one more page while a token is returned.
+		totalPages := pageIndex
+		if page.NextPageToken != "" && page.NextPageToken != pageToken {
+			totalPages++
+		}
+		pageToken = page.NextPageToken
+
+		return q.filter(page.Items), Pagination{PageIndex: pageIndex, TotalPages: totalPages}, nil
+	})
+}
+
+// getSchedulesConcurrently fetches each chunk of studios separately with
+// bounded parallelism and merges the results deterministically.
+func (c *Client) getSchedulesConcurrently(
+	ctx context.Context,
+	chunks [][]string,
+	q *scheduleQuery,
+) (StudioScheduleResponse, error) {
+	results := make([]StudioScheduleResponse, len(chunks))
+
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(c.chunkConcurrency())
+
+	for i, chunk := range chunks {
+		params := cloneValues(q.params)
+		params[StudioIDsQueryParamKey] = chunk
+
+		g.Go(func() error {
+			res, err := c.getSchedules(ctx, params)
+			if err != nil {
+				return fmt.Errorf("error fetching schedule for studios %s: %w", strings.Join(chunk, ","), err)
+			}
+
+			results[i] = res
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return StudioScheduleResponse{}, err
+	}
+
+	return mergeSchedules(results...), nil
+}
+
+// mergeSchedules concatenates schedules, dropping duplicate classes, and
+// orders the items by start time, studio and class ID.
+func mergeSchedules(schedules ...StudioScheduleResponse) StudioScheduleResponse {
+	merged := StudioScheduleResponse{}
+	seen := make(map[string]bool)
+
+	for _, s := range schedules {
+		for _, sc := range s.Items {
+			if seen[sc.ID] {
+				continue
+			}
+			seen[sc.ID] = true
+			merged.Items = append(merged.Items, sc)
+		}
+	}
+
+	sort.SliceStable(merged.Items, func(i, j int) bool {
+		a, b := merged.Items[i], merged.Items[j]
+		if !a.StartsAt.Equal(b.StartsAt) {
+			return a.StartsAt.Before(b.StartsAt)
+		}
+		if a.Studio.ID != b.Studio.ID {
+			return a.Studio.ID < b.Studio.ID
+		}
+		return a.ID < b.ID
+	})
+
+	return merged
+}
+
+func cloneValues(v url.Values) url.Values {
+	out := make(url.Values, len(v))
+	for k, vals := range v {
+		out[k] = append([]string(nil), vals...)
+	}
+
+	return out
+}
+
+// GetClassesByCoach returns the classes at the given studios taught by the
+// coach. coachName matches the coach's first or full name, ignoring case.
+func (c *Client) GetClassesByCoach(
+	ctx context.Context,
+	studioIDs []string,
+	coachName string,
+	opts ...ScheduleOption,
+) ([]StudioClass, error) {
+	schedule, err := c.GetStudiosSchedules(ctx, studioIDs, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var classes []StudioClass
+	for _, sc := range schedule.Items {
+		if sc.Coach.Matches(coachName) {
+			classes = append(classes, sc)
+		}
+	}
+
+	return classes, nil
+}
+
+// Matches reports whether name refers to the coach, by first name or full
+// name, ignoring case and surrounding whitespace.
+func (cc StudioClassCoach) Matches(name string) bool {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return false
+	}
+
+	return strings.EqualFold(name, cc.FirstName) || strings.EqualFold(name, cc.Name())
+}
+
+// GetClassTypeFilter returns the filters the classes endpoint accepts and
+// their possible values.
 func (c *Client) GetClassTypeFilter(
 	ctx context.Context,
 ) (ClassTypeFiltersResponse, error) {
@@ -104,14 +390,8 @@ func (c *Client) GetClassTypeFilter(
 		return ClassTypeFiltersResponse{}, err
 	}
 
-	res, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return ClassTypeFiltersResponse{}, err
-	}
-	defer res.Body.Close()
-
 	parsedResp := ClassTypeFiltersResponse{}
-	err = json.NewDecoder(res.Body).Decode(&parsedResp)
+	err = c.do(req, &parsedResp)
 	if err != nil {
 		return ClassTypeFiltersResponse{}, err
 	}
@@ -0,0 +1,102 @@
+package otf_api
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+)
+
+// scheduleCache keeps schedule responses in memory for a fixed TTL.
+type scheduleCache struct {
+	ttl time.Duration
+
+	mu      sync.Mutex
+	entries map[string]scheduleCacheEntry
+}
+
+type scheduleCacheEntry struct {
+	resp    StudioScheduleResponse
+	expires time.Time
+}
+
+// WithScheduleCache caches schedule responses in memory for ttl, keyed by
+// the studio set and query options, so polling loops within the TTL do not
+// re-hit the API. Use InvalidateScheduleCache to drop cached entries.
+func WithScheduleCache(ttl time.Duration) Option {
+	return func(c *Client) error {
+		if ttl <= 0 {
+			return fmt.Errorf("schedule cache ttl must be positive")
+		}
+
+		c.scheduleCache = &scheduleCache{
+			ttl:     ttl,
+			entries: make(map[string]scheduleCacheEntry),
+		}
+		return nil
+	}
+}
+
+// InvalidateScheduleCache drops every cached schedule.
+func (c *Client) InvalidateScheduleCache() {
+	if c.scheduleCache == nil {
+		return
+	}
+
+	c.scheduleCache.mu.Lock()
+	defer c.scheduleCache.mu.Unlock()
+
+	clear(c.scheduleCache.entries)
+}
+
+// cacheKey identifies a query independently of the order studio IDs were
+// passed in, including options that are only applied client-side.
+func (q *scheduleQuery) cacheKey() string {
+	key := cloneValues(q.params)
+	slices.Sort(key[StudioIDsQueryParamKey])
+	if q.homeStudioOnly {
+		key.Set("home_studio", q.homeStudioUUID)
+	}
+
+	return key.Encode()
+}
+
+func (sc *scheduleCache) get(key string) (StudioScheduleResponse, bool) {
+	if sc == nil {
+		return StudioScheduleResponse{}, false
+	}
+
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	entry, ok := sc.entries[key]
+	if !ok {
+		return StudioScheduleResponse{}, false
+	}
+	if time.Now().After(entry.expires) {
+		delete(sc.entries, key)
+		return StudioScheduleResponse{}, false
+	}
+
+	return copySchedule(entry.resp), true
+}
+
+func (sc *scheduleCache) set(key string, resp StudioScheduleResponse) {
+	if sc == nil {
+		return
+	}
+
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	sc.entries[key] = scheduleCacheEntry{
+		resp:    copySchedule(resp),
+		expires: time.Now().Add(sc.ttl),
+	}
+}
+
+// copySchedule copies the items so callers cannot modify cached data.
+func copySchedule(resp StudioScheduleResponse) StudioScheduleResponse {
+	resp.Items = slices.Clone(resp.Items)
+	return resp
+}
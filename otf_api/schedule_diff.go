@@ -0,0 +1,65 @@
+package otf_api
+
+// ClassChange is a class present in both schedules that changed.
+type ClassChange struct {
+	Previous StudioClass
+	Current  StudioClass
+}
+
+// ScheduleChanges is the difference between two schedule fetches.
+type ScheduleChanges struct {
+	Added   []StudioClass
+	Removed []StudioClass
+	// Canceled classes were not canceled in the previous schedule.
+	Canceled     []ClassChange
+	TimeChanged  []ClassChange
+	CoachChanged []ClassChange
+}
+
+// Empty reports whether nothing changed.
+func (sc ScheduleChanges) Empty() bool {
+	return len(sc.Added) == 0 && len(sc.Removed) == 0 && len(sc.Canceled) == 0 &&
+		len(sc.TimeChanged) == 0 && len(sc.CoachChanged) == 0
+}
+
+// ScheduleDiff compares two fetches of a schedule by class ID. A class can
+// appear in several change lists, e.g. when it was both moved and given a
+// new coach. Results follow the order of the schedule they come from.
+func ScheduleDiff(previous StudioScheduleResponse, current StudioScheduleResponse) ScheduleChanges {
+	changes := ScheduleChanges{}
+
+	prevByID := make(map[string]StudioClass, len(previous.Items))
+	for _, sc := range previous.Items {
+		prevByID[sc.ID] = sc
+	}
+
+	curIDs := make(map[string]bool, len(current.Items))
+	for _, cur := range current.Items {
+		curIDs[cur.ID] = true
+
+		prev, ok := prevByID[cur.ID]
+		if !ok {
+			changes.Added = append(changes.Added, cur)
+			continue
+		}
+
+		change := ClassChange{Previous: prev, Current: cur}
+		if cur.Canceled && !prev.Canceled {
+			changes.Canceled = append(changes.Canceled, change)
+		}
+		if !cur.StartsAt.Equal(prev.StartsAt) || !cur.EndsAt.Equal(prev.EndsAt) {
+			changes.TimeChanged = append(changes.TimeChanged, change)
+		}
+		if cur.Coach.Name() != prev.Coach.Name() {
+			changes.CoachChanged = append(changes.CoachChanged, change)
+		}
+	}
+
+	for _, prev := range previous.Items {
+		if !curIDs[prev.ID] {
+			changes.Removed = append(changes.Removed, prev)
+		}
+	}
+
+	return changes
+}
@@ -0,0 +1,86 @@
+package otf_api
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// DaySchedule is the classes scheduled on one studio-local day.
+type DaySchedule struct {
+	// Date is midnight of the day in the time zone of its first class.
+	Date    time.Time
+	Classes []StudioClass
+}
+
+// GroupByDay groups the schedule's classes by the day they start on in the
+// studio's local time. Days are returned in order and each day's classes
+// are sorted by start time, studio and name.
+func GroupByDay(schedule StudioScheduleResponse) []DaySchedule {
+	var days []DaySchedule
+	index := make(map[string]int)
+
+	for _, sc := range schedule.Items {
+		start := sc.StartsAtLocal()
+		key := start.Format(dateLayout)
+
+		i, ok := index[key]
+		if !ok {
+			i = len(days)
+			index[key] = i
+			days = append(days, DaySchedule{
+				Date: time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, start.Location()),
+			})
+		}
+		days[i].Classes = append(days[i].Classes, sc)
+	}
+
+	sort.SliceStable(days, func(i, j int) bool {
+		return days[i].Date.Format(dateLayout) < days[j].Date.Format(dateLayout)
+	})
+	for _, day := range days {
+		SortBy(day.Classes, SortByStartTime, SortByStudio, SortByName)
+	}
+
+	return days
+}
+
+// SortKey is a field classes can be ordered by.
+type SortKey int
+
+const (
+	SortByStartTime SortKey = iota
+	SortByStudio
+	SortByName
+)
+
+// SortBy sorts classes in place by the keys, in order of precedence. With
+// no keys the classes are sorted by start time.
+func SortBy(classes []StudioClass, keys ...SortKey) {
+	if len(keys) == 0 {
+		keys = []SortKey{SortByStartTime}
+	}
+
+	sort.SliceStable(classes, func(i, j int) bool {
+		a, b := classes[i], classes[j]
+		for _, key := range keys {
+			if c := compareBy(a, b, key); c != 0 {
+				return c < 0
+			}
+		}
+		return false
+	})
+}
+
+func compareBy(a StudioClass, b StudioClass, key SortKey) int {
+	switch key {
+	case SortByStartTime:
+		return a.StartsAt.Compare(b.StartsAt)
+	case SortByStudio:
+		return strings.Compare(a.Studio.Name, b.Studio.Name)
+	case SortByName:
+		return strings.Compare(a.Name, b.Name)
+	}
+
+	return 0
+}
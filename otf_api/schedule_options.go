@@ -0,0 +1,187 @@
+package otf_api
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+const (
+	ClassTypeQueryParamKey       = "class_type"
+	IncludeCanceledQueryParamKey = "include_canceled"
+	AvailableOnlyQueryParamKey   = "available_only"
+	VirtualQueryParamKey         = "is_virtual"
+)
+
+// ClassType is a class format value accepted by the schedules filter.
+// The full list of values is available from GetClassTypeFilter.
+type ClassType string
+
+const (
+	ClassTypeOrange60   ClassType = "ORANGE_60"
+	ClassTypeOrange90   ClassType = "ORANGE_90"
+	ClassTypeTread50    ClassType = "TREAD_50"
+	ClassTypeStrength50 ClassType = "STRENGTH_50"
+	ClassTypeOrange3G   ClassType = "ORANGE_3G"
+)
+
+// defaultScheduleChunkSize is the most studio IDs sent in one classes
+// request; the endpoint rejects or truncates larger sets.
+const defaultScheduleChunkSize = 20
+
+// defaultChunkConcurrency is how many studio chunks are fetched at once
+// when WithScheduleConcurrency is not set.
+const defaultChunkConcurrency = 4
+
+// WithScheduleConcurrency fetches multi-studio schedules with one request
+// per studio, or per chunk when WithScheduleChunkSize is set, running at
+// most n requests at a time. This avoids single large queries that can run
+// into the client timeout.
+func WithScheduleConcurrency(n int) Option {
+	return func(c *Client) error {
+		if n < 1 {
+			return fmt.Errorf("schedule concurrency must be at least 1")
+		}
+
+		c.scheduleConcurrency = n
+		return nil
+	}
+}
+
+// WithScheduleChunkSize sets how many studio IDs are sent per classes
+// request. Larger sets are split into chunks and merged transparently;
+// chunks are fetched up to 4 at a time unless WithScheduleConcurrency says
+// otherwise.
+func WithScheduleChunkSize(n int) Option {
+	return func(c *Client) error {
+		if n < 1 {
+			return fmt.Errorf("schedule chunk size must be at least 1")
+		}
+
+		c.scheduleChunkSize = n
+		return nil
+	}
+}
+
+// studioChunks splits studioIDs into the chunks requested together.
+func (c *Client) studioChunks(studioIDs []string) [][]string {
+	size := c.scheduleChunkSize
+	if size == 0 {
+		size = defaultScheduleChunkSize
+		if c.scheduleConcurrency > 1 {
+			size = 1
+		}
+	}
+
+	var chunks [][]string
+	for len(studioIDs) > size {
+		chunks = append(chunks, studioIDs[:size:size])
+		studioIDs = studioIDs[size:]
+	}
+
+	return append(chunks, studioIDs)
+}
+
+// ScheduleOption narrows a schedules query.
+type ScheduleOption func(*scheduleQuery)
+
+type scheduleQuery struct {
+	params url.Values
+
+	excludeCanceled bool
+	availableOnly   bool
+	homeStudioOnly  bool
+	homeStudioUUID  string
+	virtualOnly     bool
+}
+
+func newScheduleQuery(studioIDs []string, opts []ScheduleOption) *scheduleQuery {
+	q := &scheduleQuery{
+		params: url.Values{
+			StudioIDsQueryParamKey: studioIDs,
+		},
+	}
+
+	for _, opt := range opts {
+		opt(q)
+	}
+
+	return q
+}
+
+// WithClassTypes only returns classes of the given types.
+func WithClassTypes(types ...ClassType) ScheduleOption {
+	return func(q *scheduleQuery) {
+		for _, t := range types {
+			q.params.Add(ClassTypeQueryParamKey, string(t))
+		}
+	}
+}
+
+// WithFilter applies a filter returned by GetClassTypeFilter, using the
+// filter item's name as the query parameter and the selected values.
+func WithFilter(name string, values ...string) ScheduleOption {
+	return func(q *scheduleQuery) {
+		for _, v := range values {
+			q.params.Add(name, v)
+		}
+	}
+}
+
+// WithIncludeCanceled controls whether canceled classes are returned.
+func WithIncludeCanceled(include bool) ScheduleOption {
+	return func(q *scheduleQuery) {
+		q.params.Set(IncludeCanceledQueryParamKey, strconv.FormatBool(include))
+		q.excludeCanceled = !include
+	}
+}
+
+// WithAvailableOnly only returns classes that still have open spots.
+func WithAvailableOnly() ScheduleOption {
+	return func(q *scheduleQuery) {
+		q.params.Set(AvailableOnlyQueryParamKey, "true")
+		q.availableOnly = true
+	}
+}
+
+// filter drops items the server should have excluded, in case it ignored
+// a toggle.
+func (q *scheduleQuery) filter(items []StudioClass) []StudioClass {
+	if !q.excludeCanceled && !q.availableOnly && !q.homeStudioOnly && !q.virtualOnly {
+		return items
+	}
+
+	filtered := items[:0]
+	for _, sc := range items {
+		if q.keep(sc) {
+			filtered = append(filtered, sc)
+		}
+	}
+
+	return filtered
+}
+
+// keep reports whether sc matches the query's toggles.
+func (q *scheduleQuery) keep(sc StudioClass) bool {
+	switch {
+	case q.excludeCanceled && sc.Canceled:
+		return false
+	case q.availableOnly && sc.IsFull():
+		return false
+	case q.homeStudioOnly && !sc.IsHomeStudio(q.homeStudioUUID):
+		return false
+	case q.virtualOnly && !sc.Virtual:
+		return false
+	}
+
+	return true
+}
+
+// chunkConcurrency returns how many chunks may be fetched at once.
+func (c *Client) chunkConcurrency() int {
+	if c.scheduleConcurrency > 0 {
+		return c.scheduleConcurrency
+	}
+
+	return defaultChunkConcurrency
+}
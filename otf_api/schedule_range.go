@@ -0,0 +1,89 @@
+package otf_api
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	ScheduleStartDateQueryParamKey = "start_date"
+	ScheduleEndDateQueryParamKey   = "end_date"
+
+	// scheduleWindow is the longest range requested from the classes
+	// endpoint at once.
+	scheduleWindow = 7 * 24 * time.Hour
+)
+
+// WithDateRange only returns classes on the dates from start to end,
+// inclusive.
+func WithDateRange(start time.Time, end time.Time) ScheduleOption {
+	return func(q *scheduleQuery) {
+		q.params.Set(ScheduleStartDateQueryParamKey, start.Format(dateLayout))
+		q.params.Set(ScheduleEndDateQueryParamKey, end.Format(dateLayout))
+	}
+}
+
+// GetStudiosSchedulesRange returns the classes at the given studios
+// starting between from and to. Long ranges are split into week-long
+// windows, fetched in parallel when the client is configured with
+// WithScheduleConcurrency, and merged without duplicates.
+func (c *Client) GetStudiosSchedulesRange(
+	ctx context.Context,
+	studioIDs []string,
+	from time.Time,
+	to time.Time,
+	opts ...ScheduleOption,
+) (StudioScheduleResponse, error) {
+	if !from.Before(to) {
+		return StudioScheduleResponse{}, fmt.Errorf("invalid schedule range: %s is not before %s", from, to)
+	}
+
+	var windows [][2]time.Time
+	for start := from; start.Before(to); start = start.Add(scheduleWindow) {
+		end := start.Add(scheduleWindow)
+		if end.After(to) {
+			end = to
+		}
+		windows = append(windows, [2]time.Time{start, end})
+	}
+
+	results := make([]StudioScheduleResponse, len(windows))
+
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(max(c.scheduleConcurrency, 1))
+
+	for i, w := range windows {
+		windowOpts := append(opts[:len(opts):len(opts)], WithDateRange(w[0], w[1]))
+
+		g.Go(func() error {
+			res, err := c.GetStudiosSchedules(ctx, studioIDs, windowOpts...)
+			if err != nil {
+				return fmt.Errorf("error fetching schedule from %s to %s: %w",
+					w[0].Format(dateLayout), w[1].Format(dateLayout), err)
+			}
+
+			results[i] = res
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return StudioScheduleResponse{}, err
+	}
+
+	merged := mergeSchedules(results...)
+
+	// Windows are requested by date, so trim classes outside the range.
+	items := merged.Items[:0]
+	for _, sc := range merged.Items {
+		if !sc.StartsAt.Before(from) && sc.StartsAt.Before(to) {
+			items = append(items, sc)
+		}
+	}
+	merged.Items = items
+
+	return merged, nil
+}
@@ -0,0 +1,179 @@
+package otf_api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// ErrStopStream can be returned by a StreamStudiosSchedules callback to
+// stop streaming without an error.
+var ErrStopStream = errors.New("stop streaming")
+
+// StreamStudiosSchedules calls fn for each class at the given studios as
+// it is decoded from the response, without holding whole pages in memory.
+// Returning an error from fn stops streaming; the error is returned unless
+// it is ErrStopStream.
+//
+// Unlike GetStudiosSchedules, results are neither cached, merged nor
+// sorted: chunks of studios are streamed one after another in server
+// order.
+func (c *Client) StreamStudiosSchedules(
+	ctx context.Context,
+	studioIDs []string,
+	fn func(StudioClass) error,
+	opts ...ScheduleOption,
+) error {
+	q := newScheduleQuery(studioIDs, opts)
+	if q.homeStudioOnly {
+		home, err := c.HomeStudioUUID(ctx)
+		if err != nil {
+			return err
+		}
+		q.homeStudioUUID = home
+	}
+
+	keep := func(sc StudioClass) error {
+		if !q.keep(sc) {
+			return nil
+		}
+		return fn(sc)
+	}
+
+	for _, chunk := range c.studioChunks(studioIDs) {
+		params := cloneValues(q.params)
+		params[StudioIDsQueryParamKey] = chunk
+
+		err := c.streamSchedules(ctx, params, keep)
+		if errors.Is(err, ErrStopStream) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// streamSchedules streams every page of a classes query.
+func (c *Client) streamSchedules(
+	ctx context.Context,
+	params url.Values,
+	fn func(StudioClass) error,
+) error {
+	pageToken := ""
+	for {
+		if pageToken != "" {
+			params = cloneValues(params)
+			params.Set(PageTokenQueryParamKey, pageToken)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.BaseIOURL+"classes?"+params.Encode(), nil)
+		if err != nil {
+			return err
+		}
+
+		var (
+			next  string
+			fnErr error
+		)
+		err = c.doStream(req, func(body io.Reader) error {
+			var err error
+			next, err = decodeScheduleStream(body, func(sc StudioClass) error {
+				fnErr = fn(sc)
+				return fnErr
+			})
+			return err
+		})
+		if fnErr != nil {
+			return fnErr
+		}
+		if err != nil {
+			return err
+		}
+
+		if next == "" || next == pageToken {
+			return nil
+		}
+		pageToken = next
+	}
+}
+
+// decodeScheduleStream walks a classes response token by token, calling fn
+// for each item, and returns the next page token. An error returned by fn
+// stops decoding and is returned as is.
+func decodeScheduleStream(r io.Reader, fn func(StudioClass) error) (string, error) {
+	dec := json.NewDecoder(r)
+
+	if err := expectDelim(dec, '{'); err != nil {
+		return "", err
+	}
+
+	nextPageToken := ""
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return "", err
+		}
+
+		switch tok {
+		case "items":
+			tok, err := dec.Token()
+			if err != nil {
+				return "", err
+			}
+			if tok == nil {
+				continue
+			}
+			if tok != json.Delim('[') {
+				return "", fmt.Errorf("unexpected %v for items", tok)
+			}
+
+			for dec.More() {
+				sc := StudioClass{}
+				if err := dec.Decode(&sc); err != nil {
+					return "", err
+				}
+				if err := fn(sc); err != nil {
+					return "", err
+				}
+			}
+
+			if err := expectDelim(dec, ']'); err != nil {
+				return "", err
+			}
+		case "next_page_token":
+			var token *string
+			if err := dec.Decode(&token); err != nil {
+				return "", err
+			}
+			if token != nil {
+				nextPageToken = *token
+			}
+		default:
+			var skip json.RawMessage
+			if err := dec.Decode(&skip); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	return nextPageToken, expectDelim(dec, '}')
+}
+
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok != delim {
+		return fmt.Errorf("expected %v, got %v", delim, tok)
+	}
+
+	return nil
+}
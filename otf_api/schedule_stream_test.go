@@ -0,0 +1,192 @@
+package otf_api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestDecodeScheduleStream(t *testing.T) {
+	tests := []struct {
+		name      string
+		body      string
+		wantIDs   []string
+		wantToken string
+	}{
+		{
+			name:      "items then token",
+			body:      `{"items":[{"id":"a"},{"id":"b"}],"next_page_token":"p2"}`,
+			wantIDs:   []string{"a", "b"},
+			wantToken: "p2",
+		},
+		{
+			name:      "token then items with unknown fields",
+			body:      `{"next_page_token":"p3","meta":{"count":1},"items":[{"id":"c","extra":[1,2]}]}`,
+			wantIDs:   []string{"c"},
+			wantToken: "p3",
+		},
+		{
+			name: "null items and token",
+			body: `{"items":null,"next_page_token":null}`,
+		},
+		{
+			name: "empty items",
+			body: `{"items":[]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ids []string
+			token, err := decodeScheduleStream(strings.NewReader(tt.body), func(sc StudioClass) error {
+				ids = append(ids, sc.ID)
+				return nil
+			})
+			if err != nil {
+				t.Fatalf("decodeScheduleStream: %v", err)
+			}
+
+			if strings.Join(ids, ",") != strings.Join(tt.wantIDs, ",") {
+				t.Errorf("ids = %v, want %v", ids, tt.wantIDs)
+			}
+			if token != tt.wantToken {
+				t.Errorf("token = %q, want %q", token, tt.wantToken)
+			}
+		})
+	}
+}
+
+func TestDecodeScheduleStreamCallbackError(t *testing.T) {
+	errBoom := errors.New("boom")
+	body := `{"items":[{"id":"a"},{"id":"b"},{"id":"c"}],"next_page_token":"p2"}`
+
+	var ids []string
+	_, err := decodeScheduleStream(strings.NewReader(body), func(sc StudioClass) error {
+		ids = append(ids, sc.ID)
+		if sc.ID == "b" {
+			return errBoom
+		}
+		return nil
+	})
+
+	if !errors.Is(err, errBoom) {
+		t.Fatalf("err = %v, want %v", err, errBoom)
+	}
+	if strings.Join(ids, ",") != "a,b" {
+		t.Errorf("ids = %v, want decoding to stop after b", ids)
+	}
+}
+
+func TestDecodeScheduleStreamMalformed(t *testing.T) {
+	for _, body := range []string{
+		`[]`,
+		`{"items":{}}`,
+		`{"items":[{"id":"a"}`,
+	} {
+		_, err := decodeScheduleStream(strings.NewReader(body), func(StudioClass) error { return nil })
+		if err == nil {
+			t.Errorf("decodeScheduleStream(%s): expected an error", body)
+		}
+	}
+}
+
+// newScheduleStreamServer serves pages of classes keyed by page token, the
+// first page under the empty token, and records the tokens requested.
+func newScheduleStreamServer(t *testing.T, pages map[string]string) (*Client, *[]string) {
+	var requested []string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := r.URL.Query().Get(PageTokenQueryParamKey)
+		requested = append(requested, token)
+
+		page, ok := pages[token]
+		if !ok {
+			t.Errorf("unexpected page token %q", token)
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, page)
+	}))
+	t.Cleanup(srv.Close)
+
+	c, err := NewClient(WithBaseURLs(srv.URL+"/io/", srv.URL+"/co/"))
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	return c, &requested
+}
+
+func TestStreamStudiosSchedulesPages(t *testing.T) {
+	pages := map[string]string{
+		"":   `{"items":[{"id":"a"},{"id":"b"}],"next_page_token":"p2"}`,
+		"p2": `{"items":[{"id":"c"}],"next_page_token":"p3"}`,
+		"p3": `{"items":[{"id":"d"},{"id":"e"}],"next_page_token":null}`,
+	}
+
+	t.Run("all items", func(t *testing.T) {
+		c, requested := newScheduleStreamServer(t, pages)
+
+		var ids []string
+		err := c.StreamStudiosSchedules(context.Background(), []string{"studio-1"}, func(sc StudioClass) error {
+			ids = append(ids, sc.ID)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("StreamStudiosSchedules: %v", err)
+		}
+
+		if strings.Join(ids, ",") != "a,b,c,d,e" {
+			t.Errorf("ids = %v, want a,b,c,d,e", ids)
+		}
+		if strings.Join(*requested, ",") != ",p2,p3" {
+			t.Errorf("requested pages %q, want three pages", *requested)
+		}
+	})
+
+	t.Run("callback error stops paging", func(t *testing.T) {
+		c, requested := newScheduleStreamServer(t, pages)
+		errBoom := errors.New("boom")
+
+		var ids []string
+		err := c.StreamStudiosSchedules(context.Background(), []string{"studio-1"}, func(sc StudioClass) error {
+			ids = append(ids, sc.ID)
+			if sc.ID == "c" {
+				return errBoom
+			}
+			return nil
+		})
+
+		if !errors.Is(err, errBoom) {
+			t.Fatalf("err = %v, want %v", err, errBoom)
+		}
+		if strings.Join(ids, ",") != "a,b,c" {
+			t.Errorf("ids = %v, want a,b,c", ids)
+		}
+		if len(*requested) != 2 {
+			t.Errorf("requested pages %q, want paging to stop after p2", *requested)
+		}
+	})
+
+	t.Run("ErrStopStream", func(t *testing.T) {
+		c, _ := newScheduleStreamServer(t, pages)
+
+		var ids []string
+		err := c.StreamStudiosSchedules(context.Background(), []string{"studio-1"}, func(sc StudioClass) error {
+			ids = append(ids, sc.ID)
+			return ErrStopStream
+		})
+
+		if err != nil {
+			t.Fatalf("err = %v, want nil", err)
+		}
+		if strings.Join(ids, ",") != "a" {
+			t.Errorf("ids = %v, want a", ids)
+		}
+	})
+}
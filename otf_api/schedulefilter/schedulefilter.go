@@ -0,0 +1,131 @@
+// Package schedulefilter provides composable predicates for selecting
+// classes from a schedule, e.g. "weekday 6am Tread 50 classes":
+//
+//	classes := schedulefilter.Filter(schedule.Items,
+//		schedulefilter.ByWeekday(time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday),
+//		schedulefilter.ByTimeWindow(schedulefilter.TimeOfDay{Hour: 6}, schedulefilter.TimeOfDay{Hour: 7}),
+//		schedulefilter.ByClassName("tread 50"),
+//	)
+package schedulefilter
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ammiranda/otf_api/otf_api"
+)
+
+// Predicate selects classes.
+type Predicate func(otf_api.StudioClass) bool
+
+// Filter returns the classes matching every predicate.
+func Filter(items []otf_api.StudioClass, preds ...Predicate) []otf_api.StudioClass {
+	var out []otf_api.StudioClass
+	for _, sc := range items {
+		if All(preds...)(sc) {
+			out = append(out, sc)
+		}
+	}
+
+	return out
+}
+
+// All matches classes matching every predicate.
+func All(preds ...Predicate) Predicate {
+	return func(sc otf_api.StudioClass) bool {
+		for _, p := range preds {
+			if !p(sc) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Any matches classes matching at least one predicate.
+func Any(preds ...Predicate) Predicate {
+	return func(sc otf_api.StudioClass) bool {
+		for _, p := range preds {
+			if p(sc) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Not inverts a predicate.
+func Not(pred Predicate) Predicate {
+	return func(sc otf_api.StudioClass) bool {
+		return !pred(sc)
+	}
+}
+
+// TimeOfDay is a wall-clock time.
+type TimeOfDay struct {
+	Hour   int
+	Minute int
+}
+
+// ParseTimeOfDay parses a 24-hour "15:04" time.
+func ParseTimeOfDay(s string) (TimeOfDay, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return TimeOfDay{}, fmt.Errorf("invalid time of day %q: %w", s, err)
+	}
+
+	return TimeOfDay{Hour: t.Hour(), Minute: t.Minute()}, nil
+}
+
+func (t TimeOfDay) minutes() int {
+	return t.Hour*60 + t.Minute
+}
+
+// ByWeekday matches classes starting on one of the weekdays, in studio
+// local time.
+func ByWeekday(days ...time.Weekday) Predicate {
+	return func(sc otf_api.StudioClass) bool {
+		return slices.Contains(days, sc.StartsAtLocal().Weekday())
+	}
+}
+
+// ByTimeWindow matches classes starting at or after from and before to,
+// in studio local time.
+func ByTimeWindow(from TimeOfDay, to TimeOfDay) Predicate {
+	return func(sc otf_api.StudioClass) bool {
+		local := sc.StartsAtLocal()
+		start := TimeOfDay{Hour: local.Hour(), Minute: local.Minute()}.minutes()
+		return start >= from.minutes() && start < to.minutes()
+	}
+}
+
+// ByClassName matches classes whose name contains name, ignoring case.
+func ByClassName(name string) Predicate {
+	name = strings.ToLower(name)
+	return func(sc otf_api.StudioClass) bool {
+		return strings.Contains(strings.ToLower(sc.Name), name)
+	}
+}
+
+// ByCoach matches classes taught by the coach, by first or full name.
+func ByCoach(name string) Predicate {
+	return func(sc otf_api.StudioClass) bool {
+		return sc.Coach.Matches(name)
+	}
+}
+
+// ByStudio matches classes at one of the studios.
+func ByStudio(studioIDs ...string) Predicate {
+	return func(sc otf_api.StudioClass) bool {
+		return slices.Contains(studioIDs, sc.Studio.ID)
+	}
+}
+
+// OpenSpotsOnly matches classes that still have open spots.
+func OpenSpotsOnly() Predicate {
+	return func(sc otf_api.StudioClass) bool {
+		return !sc.IsFull()
+	}
+}
@@ -0,0 +1,57 @@
+package otf_api
+
+import "strings"
+
+// SpecialEvent identifies a limited-edition or special-format class.
+type SpecialEvent string
+
+const (
+	SpecialEventNone         SpecialEvent = ""
+	SpecialEventHellWeek     SpecialEvent = "Hell Week"
+	SpecialEventAllOutMayhem SpecialEvent = "All Out Mayhem"
+	SpecialEventDriTri       SpecialEvent = "DriTri"
+	SpecialEventHoliday      SpecialEvent = "Holiday"
+)
+
+var specialEventKeywords = []struct {
+	keyword string
+	event   SpecialEvent
+}{
+	{"hell week", SpecialEventHellWeek},
+	{"hellweek", SpecialEventHellWeek},
+	{"all out mayhem", SpecialEventAllOutMayhem},
+	{"mayhem", SpecialEventAllOutMayhem},
+	{"dritri", SpecialEventDriTri},
+	{"dri-tri", SpecialEventDriTri},
+	{"holiday", SpecialEventHoliday},
+	{"thanksgiving", SpecialEventHoliday},
+	{"christmas", SpecialEventHoliday},
+	{"new year", SpecialEventHoliday},
+	{"memorial day", SpecialEventHoliday},
+	{"labor day", SpecialEventHoliday},
+	{"july 4", SpecialEventHoliday},
+	{"4th of july", SpecialEventHoliday},
+}
+
+// DetectSpecialEvent infers the special event a class belongs to from its
+// name. SpecialEventNone is returned for regular classes.
+func DetectSpecialEvent(className string) SpecialEvent {
+	name := strings.ToLower(className)
+	for _, k := range specialEventKeywords {
+		if strings.Contains(name, k.keyword) {
+			return k.event
+		}
+	}
+
+	return SpecialEventNone
+}
+
+// SpecialEvent returns the special event the class belongs to, if any.
+func (sc StudioClass) SpecialEvent() SpecialEvent {
+	return DetectSpecialEvent(sc.Name)
+}
+
+// IsSpecial reports whether the class is a special-format or holiday class.
+func (sc StudioClass) IsSpecial() bool {
+	return sc.SpecialEvent() != SpecialEventNone
+}
@@ -0,0 +1,72 @@
+package otf_api
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// StatsPeriod is the time range member stats are aggregated over.
+type StatsPeriod string
+
+const (
+	StatsPeriodAllTime   StatsPeriod = "allTime"
+	StatsPeriodThisWeek  StatsPeriod = "thisWeek"
+	StatsPeriodLastWeek  StatsPeriod = "lastWeek"
+	StatsPeriodThisMonth StatsPeriod = "thisMonth"
+	StatsPeriodLastMonth StatsPeriod = "lastMonth"
+	StatsPeriodThisYear  StatsPeriod = "thisYear"
+	StatsPeriodLastYear  StatsPeriod = "lastYear"
+)
+
+type StatsTotals struct {
+	TotalClasses    int     `json:"totalClassesAttended"`
+	SplatPoints     int     `json:"totalSplatPoints"`
+	Calories        int     `json:"totalCalories"`
+	Distance        float64 `json:"totalDistance"`
+	TreadDistance   float64 `json:"treadmillDistance"`
+	RowerDistance   float64 `json:"rowerDistance"`
+	AverageSplats   float64 `json:"averageSplatPoints"`
+	AverageCalories float64 `json:"averageCalories"`
+	TotalMinutes    int     `json:"totalMinutes"`
+	OTBeatClasses   int     `json:"totalOtBeatClasses"`
+}
+
+type MemberStats struct {
+	Period      StatsPeriod `json:"-"`
+	AllStats    StatsTotals `json:"allStats"`
+	InStudio    StatsTotals `json:"inStudio"`
+	OutOfStudio StatsTotals `json:"outStudio"`
+}
+
+type MemberStatsResponse struct {
+	Data MemberStats `json:"data"`
+}
+
+// GetMemberStats returns the member's totals (classes, splat points,
+// calories, distance) for the period, broken down into in-studio and
+// out-of-studio workouts.
+func (c *Client) GetMemberStats(ctx context.Context, period StatsPeriod) (MemberStats, error) {
+	memberID, err := c.requireMemberID()
+	if err != nil {
+		return MemberStats{}, err
+	}
+
+	u := c.BaseCOURL + "performance/v2/" + url.PathEscape(memberID) +
+		"/over-time/" + url.PathEscape(string(period))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return MemberStats{}, err
+	}
+
+	parsedResp := MemberStatsResponse{}
+	err = c.do(req, &parsedResp)
+	if err != nil {
+		return MemberStats{}, err
+	}
+
+	parsedResp.Data.Period = period
+
+	return parsedResp.Data, nil
+}
@@ -2,16 +2,17 @@ package otf_api
 
 import (
 	"context"
-	"encoding/json"
 	"net/http"
 	"net/url"
 	"strconv"
 )
 
 const (
-	LatitudeQueryParamKey  = "latitude"
-	LongitudeQueryParamKey = "longitude"
-	DistanceQueryParamKey  = "distance"
+	LatitudeQueryParamKey   = "latitude"
+	LongitudeQueryParamKey  = "longitude"
+	DistanceQueryParamKey   = "distance"
+	PageIndexQueryParamKey  = "pageIndex"
+	StudioUUIDQueryParamKey = "studioUUId"
 )
 
 type StudioLocation struct {
@@ -61,6 +62,48 @@ func (c *Client) ListStudios(
 	lat float64,
 	long float64,
 	distance float64,
+) (ListStudiosResponse, error) {
+	return c.listStudiosPage(ctx, lat, long, distance, 0)
+}
+
+// ListStudiosIterator returns an iterator over every studio within the
+// radius distance (in miles) from the lat/long point, following the
+// pagination block of the response.
+func (c *Client) ListStudiosIterator(
+	ctx context.Context,
+	lat float64,
+	long float64,
+	distance float64,
+) *PageIterator[Studio] {
+	return NewPageIterator(ctx, func(ctx context.Context, pageIndex int) ([]Studio, Pagination, error) {
+		res, err := c.listStudiosPage(ctx, lat, long, distance, pageIndex)
+		if err != nil {
+			return nil, Pagination{}, err
+		}
+
+		return res.Data.Data, res.Data.Pagination, nil
+	})
+}
+
+// ListStudiosAll returns every studio within the radius distance (in miles)
+// from the lat/long point across all result pages.
+func (c *Client) ListStudiosAll(
+	ctx context.Context,
+	lat float64,
+	long float64,
+	distance float64,
+) ([]Studio, error) {
+	return c.ListStudiosIterator(ctx, lat, long, distance).Collect()
+}
+
+// listStudiosPage fetches a single page of studios. A pageIndex of zero
+// lets the server pick its default page.
+func (c *Client) listStudiosPage(
+	ctx context.Context,
+	lat float64,
+	long float64,
+	distance float64,
+	pageIndex int,
 ) (ListStudiosResponse, error) {
 	params := url.Values{
 		LatitudeQueryParamKey: {
@@ -73,21 +116,18 @@ func (c *Client) ListStudios(
 			toString(distance),
 		},
 	}
-
-	u := c.BaseCOURL + "studios?" + params.Encode()
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
-	if err != nil {
-		return ListStudiosResponse{}, err
+	if pageIndex > 0 {
+		params.Set(PageIndexQueryParamKey, strconv.Itoa(pageIndex))
 	}
 
-	res, err := c.HTTPClient.Do(req)
+	u := c.BaseCOURL + "mobile/v1/studios?" + params.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
 	if err != nil {
 		return ListStudiosResponse{}, err
 	}
-	defer res.Body.Close()
 
 	parsedResp := ListStudiosResponse{}
-	err = json.NewDecoder(res.Body).Decode(&parsedResp)
+	err = c.do(req, &parsedResp)
 	if err != nil {
 		return ListStudiosResponse{}, err
 	}
@@ -0,0 +1,202 @@
+package otf_api
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// summaryPageSize is how many workouts are requested per page while
+	// walking performance summaries.
+	summaryPageSize = 50
+
+	// summaryDetailConcurrency bounds concurrent detail requests.
+	summaryDetailConcurrency = 4
+)
+
+// WorkoutSummary aggregates workouts over a date range.
+type WorkoutSummary struct {
+	Start       time.Time
+	End         time.Time
+	Classes     int
+	SplatPoints int
+	Calories    int
+	ZoneMinutes ZoneTimeMinutes
+	// TreadDistance and RowerDistance are in the display unit of the most
+	// recent workout (TreadDistanceUnit / RowerDistanceUnit); workouts
+	// recorded in other units are converted before summing.
+	TreadDistance     float64
+	TreadDistanceUnit string
+	RowerDistance     float64
+	RowerDistanceUnit string
+}
+
+// GetWorkoutSummary aggregates splat points, calories, zone minutes,
+// equipment distance and class counts for workouts that started in
+// [start, end). Performance summary pages are walked behind the scenes
+// and each workout's detail is fetched for its distances.
+func (c *Client) GetWorkoutSummary(
+	ctx context.Context,
+	start time.Time,
+	end time.Time,
+) (WorkoutSummary, error) {
+	summary := WorkoutSummary{Start: start, End: end}
+
+	workouts, err := c.performanceSummariesBetween(ctx, start, end)
+	if err != nil {
+		return WorkoutSummary{}, err
+	}
+
+	for _, w := range workouts {
+		summary.Classes++
+		summary.SplatPoints += w.Details.SplatPoints
+		summary.Calories += w.Details.CaloriesBurned
+		summary.ZoneMinutes.Gray += w.Details.ZoneTimeMinutes.Gray
+		summary.ZoneMinutes.Blue += w.Details.ZoneTimeMinutes.Blue
+		summary.ZoneMinutes.Green += w.Details.ZoneTimeMinutes.Green
+		summary.ZoneMinutes.Orange += w.Details.ZoneTimeMinutes.Orange
+		summary.ZoneMinutes.Red += w.Details.ZoneTimeMinutes.Red
+	}
+
+	details, err := c.performanceSummaryDetails(ctx, workouts)
+	if err != nil {
+		return WorkoutSummary{}, err
+	}
+
+	for _, d := range details {
+		if t := d.Details.Equipment.Treadmill; t != nil {
+			err := addDistance(&summary.TreadDistance, &summary.TreadDistanceUnit, t.TotalDistance)
+			if err != nil {
+				return WorkoutSummary{}, fmt.Errorf("workout %s treadmill distance: %w", d.ID, err)
+			}
+		}
+		if r := d.Details.Equipment.Rower; r != nil {
+			err := addDistance(&summary.RowerDistance, &summary.RowerDistanceUnit, r.TotalDistance)
+			if err != nil {
+				return WorkoutSummary{}, fmt.Errorf("workout %s rower distance: %w", d.ID, err)
+			}
+		}
+	}
+
+	return summary, nil
+}
+
+// metersPerUnit converts the distance display units used by the
+// performance endpoints to meters.
+var metersPerUnit = map[string]float64{
+	"m":          1,
+	"meters":     1,
+	"km":         1000,
+	"kilometers": 1000,
+	"mi":         1609.344,
+	"miles":      1609.344,
+}
+
+// addDistance adds m to total, which is kept in unit. The first distance
+// added sets unit; later ones are converted to it. Zero distances and
+// distances without a unit, e.g. from strength classes, are skipped.
+func addDistance(total *float64, unit *string, m Measurement) error {
+	if m.Value == 0 || m.Unit == "" {
+		return nil
+	}
+	if *unit == "" {
+		*unit = m.Unit
+	}
+	if strings.EqualFold(m.Unit, *unit) {
+		*total += m.Value
+		return nil
+	}
+
+	from, ok := metersPerUnit[strings.ToLower(m.Unit)]
+	if !ok {
+		return fmt.Errorf("unknown distance unit %q", m.Unit)
+	}
+	to, ok := metersPerUnit[strings.ToLower(*unit)]
+	if !ok {
+		return fmt.Errorf("unknown distance unit %q", *unit)
+	}
+
+	*total += m.Value * from / to
+	return nil
+}
+
+// performanceSummariesBetween pages backwards from end until workouts
+// start before start. The API's before cursor is exclusive, so each page
+// after the first restarts just past the oldest workout seen; workouts
+// sharing that start time are kept and duplicates are dropped by ID.
+func (c *Client) performanceSummariesBetween(
+	ctx context.Context,
+	start time.Time,
+	end time.Time,
+) ([]PerformanceSummary, error) {
+	var workouts []PerformanceSummary
+	seen := make(map[string]bool)
+
+	before := end
+	for {
+		page, err := c.GetPerformanceSummaries(ctx, summaryPageSize, before)
+		if err != nil {
+			return nil, err
+		}
+
+		added := 0
+		for _, w := range page {
+			if seen[w.ID] {
+				continue
+			}
+			seen[w.ID] = true
+			added++
+
+			startsAt := w.Class.StartsAt
+			if !startsAt.Before(start) && startsAt.Before(end) {
+				workouts = append(workouts, w)
+			}
+		}
+
+		if len(page) < summaryPageSize || added == 0 {
+			return workouts, nil
+		}
+
+		oldest := page[len(page)-1].Class.StartsAt
+		if oldest.Before(start) {
+			return workouts, nil
+		}
+		// The cursor is sent with second precision.
+		before = oldest.Truncate(time.Second).Add(time.Second)
+	}
+}
+
+// performanceSummaryDetails fetches the detail of each workout with
+// bounded concurrency, preserving order. Fetching stops at the first
+// error.
+func (c *Client) performanceSummaryDetails(
+	ctx context.Context,
+	workouts []PerformanceSummary,
+) ([]PerformanceSummaryDetail, error) {
+	details := make([]PerformanceSummaryDetail, len(workouts))
+
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(summaryDetailConcurrency)
+
+	for i, w := range workouts {
+		g.Go(func() error {
+			detail, err := c.GetPerformanceSummaryDetail(ctx, w.ID)
+			if err != nil {
+				return fmt.Errorf("error fetching workout %s: %w", w.ID, err)
+			}
+
+			details[i] = detail
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return details, nil
+}
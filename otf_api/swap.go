@@ -0,0 +1,101 @@
+package otf_api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// SwapResult is the outcome of SwapBooking.
+type SwapResult struct {
+	Booking  *Booking
+	Canceled CancelResult
+}
+
+// SwapBooking moves a booking to another class. The new class is booked
+// first and the existing booking is only canceled once that succeeds; if
+// the cancellation then fails, the new booking is canceled again so the
+// member is left with the original booking.
+//
+// Both late cancellation checks happen before anything is booked: opts
+// apply to the existing booking, while the new class must be outside its
+// studio's late cancel window, since rolling it back would otherwise incur
+// a fee. Such swaps fail with ErrLateCancel. The rollback itself never
+// late-cancels; if it would, the error reports it and the member keeps
+// both bookings.
+func (c *Client) SwapBooking(
+	ctx context.Context,
+	existingBookingID string,
+	newClassID string,
+	opts ...CancelOption,
+) (SwapResult, error) {
+	existing, err := c.GetBooking(ctx, existingBookingID)
+	if err != nil {
+		return SwapResult{}, err
+	}
+	if existing.Class.ClassUUID == newClassID {
+		return SwapResult{}, fmt.Errorf("booking %s is already for class %s", existingBookingID, newClassID)
+	}
+
+	if _, err := c.checkCancel(ctx, existing, opts); err != nil {
+		return SwapResult{}, err
+	}
+
+	// A booking the member already held must survive a rollback.
+	alreadyBooked, err := c.findActiveBooking(ctx, CreateBookingRequest{ClassUUID: newClassID})
+	if err != nil {
+		return SwapResult{}, fmt.Errorf("error checking existing bookings: %w", err)
+	}
+
+	if alreadyBooked == nil {
+		if err := c.checkRollback(ctx, newClassID); err != nil {
+			return SwapResult{}, err
+		}
+	}
+
+	booking, err := c.BookClass(ctx, CreateBookingRequest{ClassUUID: newClassID})
+	if err != nil {
+		return SwapResult{}, fmt.Errorf("error booking new class: %w", err)
+	}
+
+	canceled, err := c.CancelBooking(ctx, existingBookingID, opts...)
+	if err != nil && alreadyBooked != nil {
+		return SwapResult{Booking: booking}, fmt.Errorf("error canceling existing booking: %w", err)
+	}
+	if err != nil {
+		_, rollbackErr := c.CancelBooking(ctx, booking.BookingUUID)
+		if rollbackErr != nil {
+			return SwapResult{Booking: booking}, errors.Join(
+				fmt.Errorf("error canceling existing booking: %w", err),
+				fmt.Errorf("error rolling back new booking %s: %w", booking.BookingUUID, rollbackErr),
+			)
+		}
+
+		return SwapResult{}, fmt.Errorf("error canceling existing booking: %w", err)
+	}
+
+	return SwapResult{Booking: booking, Canceled: canceled}, nil
+}
+
+// checkRollback returns ErrLateCancel when a booking of the class could
+// not be canceled without a late cancel fee.
+func (c *Client) checkRollback(ctx context.Context, classID string) error {
+	class, err := c.GetClass(ctx, classID)
+	if err != nil {
+		return fmt.Errorf("error fetching new class: %w", err)
+	}
+
+	_, err = c.checkCancel(ctx, Booking{
+		Status: BookingStatusBooked,
+		Class: BookingClass{
+			ClassUUID:     class.ID,
+			StartDateTime: class.StartsAt,
+			Studio:        Studio{StudioUUID: class.Studio.ID},
+		},
+	}, nil)
+	if errors.Is(err, ErrLateCancel) {
+		return fmt.Errorf("new class %s could not be rolled back without a late cancel: %w", classID, err)
+	}
+
+	return err
+}
@@ -0,0 +1,225 @@
+package otf_api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// swapServer fakes the booking, class and cancellation policy endpoints
+// used by SwapBooking.
+type swapServer struct {
+	t *testing.T
+
+	mu       sync.Mutex
+	bookings map[string]Booking
+	classes  map[string]time.Time
+	// failCancel lists bookings whose DELETE returns a server error.
+	failCancel map[string]bool
+	posts      int
+	deletes    []string
+}
+
+func newSwapServer(t *testing.T) *swapServer {
+	return &swapServer{
+		t:          t,
+		bookings:   make(map[string]Booking),
+		classes:    make(map[string]time.Time),
+		failCancel: make(map[string]bool),
+	}
+}
+
+func (s *swapServer) addClass(id string, startsAt time.Time) {
+	s.classes[id] = startsAt
+}
+
+func (s *swapServer) addBooking(id string, classID string) {
+	s.bookings[id] = Booking{
+		BookingUUID: id,
+		Status:      BookingStatusBooked,
+		Class: BookingClass{
+			ClassUUID:     classID,
+			StartDateTime: s.classes[classID],
+			Studio:        Studio{StudioUUID: "studio-1"},
+		},
+	}
+}
+
+func (s *swapServer) status(id string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.bookings[id].Status
+}
+
+func (s *swapServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	const bookingsPath = "/co/member/members/member-1/bookings"
+
+	switch {
+	case strings.HasPrefix(r.URL.Path, "/io/classes/"):
+		id := strings.TrimPrefix(r.URL.Path, "/io/classes/")
+		startsAt, ok := s.classes[id]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		s.write(w, ClassDetail{StudioClass: StudioClass{
+			ID:       id,
+			StartsAt: startsAt,
+			Studio:   StudioClassStudio{ID: "studio-1"},
+		}})
+
+	case strings.HasSuffix(r.URL.Path, "/cancellation-policy"):
+		http.NotFound(w, r)
+
+	case r.URL.Path == bookingsPath && r.Method == http.MethodGet:
+		var list []Booking
+		for _, b := range s.bookings {
+			list = append(list, b)
+		}
+		s.write(w, BookingsResponse{Data: list})
+
+	case r.URL.Path == bookingsPath && r.Method == http.MethodPost:
+		var req CreateBookingRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			s.t.Errorf("decoding booking request: %v", err)
+		}
+		s.posts++
+		s.addBooking("booking-new", req.ClassUUID)
+		s.write(w, BookingResponse{Data: s.bookings["booking-new"]})
+
+	case strings.HasPrefix(r.URL.Path, bookingsPath+"/"):
+		id := strings.TrimPrefix(r.URL.Path, bookingsPath+"/")
+		b, ok := s.bookings[id]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+
+		if r.Method == http.MethodDelete {
+			s.deletes = append(s.deletes, id)
+			if s.failCancel[id] {
+				http.Error(w, `{"message":"cancel failed"}`, http.StatusInternalServerError)
+				return
+			}
+			b.Status = BookingStatusCancelled
+			s.bookings[id] = b
+		}
+		s.write(w, BookingResponse{Data: b})
+
+	default:
+		s.t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		http.NotFound(w, r)
+	}
+}
+
+func (s *swapServer) write(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		s.t.Errorf("encoding response: %v", err)
+	}
+}
+
+func newSwapTestClient(t *testing.T, s *swapServer) *Client {
+	srv := httptest.NewServer(s)
+	t.Cleanup(srv.Close)
+
+	c, err := NewClient(
+		WithBaseURLs(srv.URL+"/io/", srv.URL+"/co/"),
+		WithBookingThrottle(0, 0),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	c.Token = "token"
+	c.MemberID = "member-1"
+
+	return c
+}
+
+func TestSwapBooking(t *testing.T) {
+	now := time.Now()
+
+	t.Run("swaps", func(t *testing.T) {
+		s := newSwapServer(t)
+		s.addClass("class-old", now.Add(48*time.Hour))
+		s.addClass("class-new", now.Add(72*time.Hour))
+		s.addBooking("booking-old", "class-old")
+		c := newSwapTestClient(t, s)
+
+		res, err := c.SwapBooking(context.Background(), "booking-old", "class-new")
+		if err != nil {
+			t.Fatalf("SwapBooking: %v", err)
+		}
+
+		if res.Booking == nil || res.Booking.Class.ClassUUID != "class-new" {
+			t.Errorf("Booking = %+v, want a booking for class-new", res.Booking)
+		}
+		if res.Canceled.BookingID != "booking-old" || res.Canceled.LateCancel {
+			t.Errorf("Canceled = %+v, want an on-time cancel of booking-old", res.Canceled)
+		}
+		if got := s.status("booking-old"); got != BookingStatusCancelled {
+			t.Errorf("old booking status = %q, want %q", got, BookingStatusCancelled)
+		}
+		if got := s.status("booking-new"); got != BookingStatusBooked {
+			t.Errorf("new booking status = %q, want %q", got, BookingStatusBooked)
+		}
+	})
+
+	t.Run("rolls back when cancel fails", func(t *testing.T) {
+		s := newSwapServer(t)
+		s.addClass("class-old", now.Add(48*time.Hour))
+		s.addClass("class-new", now.Add(72*time.Hour))
+		s.addBooking("booking-old", "class-old")
+		s.failCancel["booking-old"] = true
+		c := newSwapTestClient(t, s)
+
+		res, err := c.SwapBooking(context.Background(), "booking-old", "class-new")
+		if err == nil {
+			t.Fatal("expected an error")
+		}
+
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusInternalServerError {
+			t.Errorf("err = %v, want the cancel's APIError", err)
+		}
+		if res.Booking != nil {
+			t.Errorf("Booking = %+v, want nil after rollback", res.Booking)
+		}
+		if got := s.status("booking-old"); got != BookingStatusBooked {
+			t.Errorf("old booking status = %q, want %q", got, BookingStatusBooked)
+		}
+		if got := s.status("booking-new"); got != BookingStatusCancelled {
+			t.Errorf("new booking status = %q, want it rolled back", got)
+		}
+	})
+
+	t.Run("refuses a rollback that would be a late cancel", func(t *testing.T) {
+		s := newSwapServer(t)
+		s.addClass("class-old", now.Add(48*time.Hour))
+		s.addClass("class-new", now.Add(2*time.Hour))
+		s.addBooking("booking-old", "class-old")
+		c := newSwapTestClient(t, s)
+
+		_, err := c.SwapBooking(context.Background(), "booking-old", "class-new")
+		if !errors.Is(err, ErrLateCancel) {
+			t.Fatalf("err = %v, want ErrLateCancel", err)
+		}
+
+		if s.posts != 0 || len(s.deletes) != 0 {
+			t.Errorf("made %d bookings and canceled %v, want no writes", s.posts, s.deletes)
+		}
+		if got := s.status("booking-old"); got != BookingStatusBooked {
+			t.Errorf("old booking status = %q, want %q", got, BookingStatusBooked)
+		}
+	})
+}
@@ -0,0 +1,178 @@
+package otf_api
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/ammiranda/otf_api/otf_api/telemetry"
+)
+
+const (
+	ClassHistoryUUIDQueryParamKey = "classHistoryUuid"
+	MaxDataPointsQueryParamKey    = "maxDataPoints"
+)
+
+type HRZoneBoundary struct {
+	StartBPM int `json:"startBpm"`
+	EndBPM   int `json:"endBpm"`
+}
+
+type HRZones struct {
+	Gray   HRZoneBoundary `json:"gray"`
+	Blue   HRZoneBoundary `json:"blue"`
+	Green  HRZoneBoundary `json:"green"`
+	Orange HRZoneBoundary `json:"orange"`
+	Red    HRZoneBoundary `json:"red"`
+}
+
+type TreadTelemetry struct {
+	Speed             float64 `json:"treadSpeed"`
+	InclinePercentage float64 `json:"treadInclinePercentage"`
+	Pace              float64 `json:"treadPace"`
+	AggDistance       float64 `json:"aggTreadDistance"`
+	AggElevation      float64 `json:"aggTreadElevation"`
+}
+
+type RowTelemetry struct {
+	Speed       float64 `json:"rowSpeed"`
+	Power       float64 `json:"rowPps"`
+	StrokeRate  float64 `json:"rowSpm"`
+	Pace        float64 `json:"rowPace"`
+	AggDistance float64 `json:"aggRowDistance"`
+}
+
+type TelemetrySample struct {
+	// RelativeTimestamp is the number of seconds since the class started.
+	RelativeTimestamp int       `json:"relativeTimestamp"`
+	Timestamp         time.Time `json:"timestamp"`
+	HR                int       `json:"hr"`
+	AggSplats         int       `json:"aggSplats"`
+	AggCalories       int       `json:"aggCalories"`
+	// Tread and Row are nil for samples recorded off that equipment.
+	Tread *TreadTelemetry `json:"treadData"`
+	Row   *RowTelemetry   `json:"rowData"`
+}
+
+type HeartRateTelemetry struct {
+	PerformanceSummaryID string            `json:"classHistoryUuid"`
+	MemberUUID           string            `json:"memberUuid"`
+	ClassStartTime       time.Time         `json:"classStartTime"`
+	MaxHR                int               `json:"maxHr"`
+	Zones                HRZones           `json:"zones"`
+	WindowSize           int               `json:"windowSize"`
+	Samples              []TelemetrySample `json:"telemetry"`
+}
+
+// Points returns the heart-rate samples as a telemetry series, ready for
+// downsampling or smoothing with the telemetry package.
+func (t HeartRateTelemetry) Points() []telemetry.Point {
+	return t.series(func(s TelemetrySample) (float64, bool) {
+		return float64(s.HR), true
+	})
+}
+
+// TreadSpeedPoints returns the treadmill speed series.
+func (t HeartRateTelemetry) TreadSpeedPoints() []telemetry.Point {
+	return t.series(func(s TelemetrySample) (float64, bool) {
+		if s.Tread == nil {
+			return 0, false
+		}
+		return s.Tread.Speed, true
+	})
+}
+
+// TreadInclinePoints returns the treadmill incline series in percent.
+func (t HeartRateTelemetry) TreadInclinePoints() []telemetry.Point {
+	return t.series(func(s TelemetrySample) (float64, bool) {
+		if s.Tread == nil {
+			return 0, false
+		}
+		return s.Tread.InclinePercentage, true
+	})
+}
+
+// TreadPacePoints returns the treadmill pace series.
+func (t HeartRateTelemetry) TreadPacePoints() []telemetry.Point {
+	return t.series(func(s TelemetrySample) (float64, bool) {
+		if s.Tread == nil {
+			return 0, false
+		}
+		return s.Tread.Pace, true
+	})
+}
+
+// RowerPowerPoints returns the rower power series in watts.
+func (t HeartRateTelemetry) RowerPowerPoints() []telemetry.Point {
+	return t.series(func(s TelemetrySample) (float64, bool) {
+		if s.Row == nil {
+			return 0, false
+		}
+		return s.Row.Power, true
+	})
+}
+
+// RowerStrokeRatePoints returns the rower stroke rate series in strokes
+// per minute.
+func (t HeartRateTelemetry) RowerStrokeRatePoints() []telemetry.Point {
+	return t.series(func(s TelemetrySample) (float64, bool) {
+		if s.Row == nil {
+			return 0, false
+		}
+		return s.Row.StrokeRate, true
+	})
+}
+
+// series extracts a time series from the samples, skipping samples for
+// which value reports false.
+func (t HeartRateTelemetry) series(value func(TelemetrySample) (float64, bool)) []telemetry.Point {
+	points := make([]telemetry.Point, 0, len(t.Samples))
+	for _, s := range t.Samples {
+		v, ok := value(s)
+		if !ok {
+			continue
+		}
+
+		ts := s.Timestamp
+		if ts.IsZero() {
+			ts = t.ClassStartTime.Add(time.Duration(s.RelativeTimestamp) * time.Second)
+		}
+
+		points = append(points, telemetry.Point{Time: ts, Value: v})
+	}
+
+	return points
+}
+
+// GetHeartRateTelemetry returns the heart-rate time series and zone
+// boundaries of a workout, along with the treadmill and rower streams
+// recorded during it. maxDataPoints limits the resolution of the
+// series; zero lets the server choose.
+func (c *Client) GetHeartRateTelemetry(
+	ctx context.Context,
+	performanceSummaryID string,
+	maxDataPoints int,
+) (HeartRateTelemetry, error) {
+	params := url.Values{
+		ClassHistoryUUIDQueryParamKey: {performanceSummaryID},
+	}
+	if maxDataPoints > 0 {
+		params.Set(MaxDataPointsQueryParamKey, strconv.Itoa(maxDataPoints))
+	}
+
+	u := c.BaseTelemetryURL + "performance/summary?" + params.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return HeartRateTelemetry{}, err
+	}
+
+	parsedResp := HeartRateTelemetry{}
+	err = c.do(req, &parsedResp)
+	if err != nil {
+		return HeartRateTelemetry{}, err
+	}
+
+	return parsedResp, nil
+}
@@ -0,0 +1,126 @@
+// Package telemetry provides helpers for reducing and smoothing workout
+// time series such as heart-rate samples.
+package telemetry
+
+import (
+	"math"
+	"time"
+)
+
+// Point is a single sample in a time series.
+type Point struct {
+	Time  time.Time
+	Value float64
+}
+
+// Downsample reduces points to at most threshold samples using the
+// Largest-Triangle-Three-Buckets algorithm, which keeps the visual shape
+// of the series (peaks and troughs) intact. The first and last points
+// are always kept, except that a threshold of 1 keeps only the first.
+// Points must be sorted by time.
+func Downsample(points []Point, threshold int) []Point {
+	if threshold >= len(points) || threshold <= 0 {
+		return points
+	}
+	switch threshold {
+	case 1:
+		return []Point{points[0]}
+	case 2:
+		return []Point{points[0], points[len(points)-1]}
+	}
+
+	sampled := make([]Point, 0, threshold)
+	sampled = append(sampled, points[0])
+
+	// Bucket size, leaving room for the fixed first and last points.
+	every := float64(len(points)-2) / float64(threshold-2)
+	a := 0
+
+	for i := 0; i < threshold-2; i++ {
+		// Average of the next bucket is the third triangle vertex.
+		nextStart := int(math.Floor(float64(i+1)*every)) + 1
+		nextEnd := min(int(math.Floor(float64(i+2)*every))+1, len(points))
+
+		var avgX, avgY float64
+		for _, p := range points[nextStart:nextEnd] {
+			avgX += x(p)
+			avgY += p.Value
+		}
+		n := float64(nextEnd - nextStart)
+		avgX /= n
+		avgY /= n
+
+		// Pick the point in the current bucket forming the largest
+		// triangle with the previously selected point and the average.
+		start := int(math.Floor(float64(i)*every)) + 1
+		end := int(math.Floor(float64(i+1)*every)) + 1
+
+		ax, ay := x(points[a]), points[a].Value
+		maxArea := -1.0
+		next := start
+
+		for j := start; j < end; j++ {
+			area := math.Abs((ax-avgX)*(points[j].Value-ay) - (ax-x(points[j]))*(avgY-ay))
+			if area > maxArea {
+				maxArea = area
+				next = j
+			}
+		}
+
+		sampled = append(sampled, points[next])
+		a = next
+	}
+
+	return append(sampled, points[len(points)-1])
+}
+
+// MovingAverage smooths points with a centered simple moving average
+// over window samples. A window of 1 or less returns a copy of points.
+func MovingAverage(points []Point, window int) []Point {
+	out := make([]Point, len(points))
+	if window <= 1 {
+		copy(out, points)
+		return out
+	}
+
+	half := window / 2
+	for i := range points {
+		lo := max(0, i-half)
+		hi := min(len(points), i+half+1)
+
+		var sum float64
+		for _, p := range points[lo:hi] {
+			sum += p.Value
+		}
+
+		out[i] = Point{Time: points[i].Time, Value: sum / float64(hi-lo)}
+	}
+
+	return out
+}
+
+// ExponentialSmoothing applies an exponential moving average with the
+// given smoothing factor alpha in (0, 1]. Smaller values smooth more.
+func ExponentialSmoothing(points []Point, alpha float64) []Point {
+	out := make([]Point, len(points))
+	if len(points) == 0 {
+		return out
+	}
+	if alpha <= 0 || alpha > 1 {
+		alpha = 1
+	}
+
+	out[0] = points[0]
+	for i := 1; i < len(points); i++ {
+		out[i] = Point{
+			Time:  points[i].Time,
+			Value: alpha*points[i].Value + (1-alpha)*out[i-1].Value,
+		}
+	}
+
+	return out
+}
+
+func x(p Point) float64 {
+	return float64(p.Time.UnixMilli())
+}
@@ -0,0 +1,133 @@
+package telemetry
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func series(values ...float64) []Point {
+	start := time.Date(2024, 5, 1, 6, 0, 0, 0, time.UTC)
+
+	points := make([]Point, len(values))
+	for i, v := range values {
+		points[i] = Point{Time: start.Add(time.Duration(i) * time.Second), Value: v}
+	}
+
+	return points
+}
+
+func TestDownsample(t *testing.T) {
+	points := series(100, 110, 150, 120, 90, 95, 170, 130, 100, 105)
+
+	tests := []struct {
+		name      string
+		points    []Point
+		threshold int
+		want      int
+	}{
+		{name: "empty", points: nil, threshold: 5, want: 0},
+		{name: "zero threshold", points: points, threshold: 0, want: len(points)},
+		{name: "negative threshold", points: points, threshold: -1, want: len(points)},
+		{name: "threshold one", points: points, threshold: 1, want: 1},
+		{name: "threshold two", points: points, threshold: 2, want: 2},
+		{name: "threshold three", points: points, threshold: 3, want: 3},
+		{name: "threshold below len", points: points, threshold: 5, want: 5},
+		{name: "threshold equals len", points: points, threshold: len(points), want: len(points)},
+		{name: "threshold above len", points: points, threshold: 20, want: len(points)},
+		{name: "short series", points: points[:2], threshold: 1, want: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Downsample(tt.points, tt.threshold)
+			if len(got) != tt.want {
+				t.Fatalf("len = %d, want %d", len(got), tt.want)
+			}
+			if len(got) == 0 {
+				return
+			}
+
+			if got[0] != tt.points[0] {
+				t.Errorf("first point = %v, want %v", got[0], tt.points[0])
+			}
+			if len(got) > 1 && got[len(got)-1] != tt.points[len(tt.points)-1] {
+				t.Errorf("last point = %v, want %v", got[len(got)-1], tt.points[len(tt.points)-1])
+			}
+			for i := 1; i < len(got); i++ {
+				if !got[i].Time.After(got[i-1].Time) {
+					t.Errorf("points not in time order at %d", i)
+				}
+			}
+		})
+	}
+}
+
+func TestDownsampleKeepsPeak(t *testing.T) {
+	points := series(100, 100, 100, 100, 190, 100, 100, 100, 100)
+
+	got := Downsample(points, 3)
+
+	if got[1].Value != 190 {
+		t.Errorf("middle point = %v, want the 190 peak", got[1].Value)
+	}
+}
+
+func TestMovingAverage(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []float64
+		window int
+		want   []float64
+	}{
+		{name: "window one copies", values: []float64{1, 5, 3}, window: 1, want: []float64{1, 5, 3}},
+		{name: "window three", values: []float64{1, 4, 7, 10}, window: 3, want: []float64{2.5, 4, 7, 8.5}},
+		{name: "empty", values: nil, window: 3, want: []float64{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			points := series(tt.values...)
+
+			got := MovingAverage(points, tt.window)
+			if len(got) != len(tt.want) {
+				t.Fatalf("len = %d, want %d", len(got), len(tt.want))
+			}
+			for i, p := range got {
+				if math.Abs(p.Value-tt.want[i]) > 1e-9 {
+					t.Errorf("value %d = %v, want %v", i, p.Value, tt.want[i])
+				}
+				if !p.Time.Equal(points[i].Time) {
+					t.Errorf("time %d = %v, want %v", i, p.Time, points[i].Time)
+				}
+			}
+		})
+	}
+}
+
+func TestExponentialSmoothing(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []float64
+		alpha  float64
+		want   []float64
+	}{
+		{name: "half", values: []float64{10, 20, 20}, alpha: 0.5, want: []float64{10, 15, 17.5}},
+		{name: "out of range alpha is unsmoothed", values: []float64{10, 20, 30}, alpha: 0, want: []float64{10, 20, 30}},
+		{name: "empty", values: nil, alpha: 0.5, want: []float64{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExponentialSmoothing(series(tt.values...), tt.alpha)
+			if len(got) != len(tt.want) {
+				t.Fatalf("len = %d, want %d", len(got), len(tt.want))
+			}
+			for i, p := range got {
+				if math.Abs(p.Value-tt.want[i]) > 1e-9 {
+					t.Errorf("value %d = %v, want %v", i, p.Value, tt.want[i])
+				}
+			}
+		})
+	}
+}
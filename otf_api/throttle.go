@@ -0,0 +1,71 @@
+package otf_api
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Default booking throttle settings. Rapid automated booking is the
+// quickest way to get an account flagged, so writes are spaced out and
+// paused after failures.
+const (
+	DefaultBookingInterval = 2 * time.Second
+	DefaultBookingCooldown = 30 * time.Second
+)
+
+// WithBookingThrottle spaces booking and cancellation requests at least
+// minInterval apart and waits cooldown after a failed one. Reads are not
+// affected. Zero values disable the respective limit.
+func WithBookingThrottle(minInterval time.Duration, cooldown time.Duration) Option {
+	return func(c *Client) error {
+		if minInterval < 0 || cooldown < 0 {
+			return fmt.Errorf("booking throttle durations must not be negative")
+		}
+
+		c.writeThrottle = &bookingThrottle{minInterval: minInterval, cooldown: cooldown}
+		return nil
+	}
+}
+
+// bookingThrottle paces write requests.
+type bookingThrottle struct {
+	minInterval time.Duration
+	cooldown    time.Duration
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+// wait blocks until the next write may be sent and reserves its slot.
+func (t *bookingThrottle) wait(ctx context.Context) error {
+	if t == nil {
+		return nil
+	}
+
+	t.mu.Lock()
+	now := time.Now()
+	at := t.next
+	if at.Before(now) {
+		at = now
+	}
+	t.next = at.Add(t.minInterval)
+	t.mu.Unlock()
+
+	return sleepUntil(ctx, at)
+}
+
+// failed delays the next write by the cooldown.
+func (t *bookingThrottle) failed() {
+	if t == nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if next := time.Now().Add(t.cooldown); next.After(t.next) {
+		t.next = next
+	}
+}
@@ -0,0 +1,127 @@
+package otf_api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// tokenExpiryLeeway is how long before expiry a cached token is no longer
+// reused.
+const tokenExpiryLeeway = time.Minute
+
+// TokenCache persists an authenticated session between processes.
+type TokenCache interface {
+	// Load returns the cached session or ErrNoCredentials when the
+	// cache is empty.
+	Load() (AuthResult, error)
+	Save(session AuthResult) error
+}
+
+// FileTokenCache stores the session as JSON in a file only readable by the
+// current user.
+type FileTokenCache struct {
+	Path string
+}
+
+// DefaultTokenCacheFile returns the path of the token cache under the
+// user's config directory, e.g. ~/.config/otf-cli/token.json.
+func DefaultTokenCacheFile() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, "otf-cli", "token.json"), nil
+}
+
+func (f FileTokenCache) Load() (AuthResult, error) {
+	data, err := os.ReadFile(f.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return AuthResult{}, ErrNoCredentials
+	}
+	if err != nil {
+		return AuthResult{}, fmt.Errorf("error reading token cache: %w", err)
+	}
+
+	session := AuthResult{}
+	err = json.Unmarshal(data, &session)
+	if err != nil {
+		return AuthResult{}, fmt.Errorf("error parsing token cache %s: %w", f.Path, err)
+	}
+
+	return session, nil
+}
+
+func (f FileTokenCache) Save(session AuthResult) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return fmt.Errorf("error encoding token cache: %w", err)
+	}
+
+	err = os.MkdirAll(filepath.Dir(f.Path), 0o700)
+	if err != nil {
+		return fmt.Errorf("error creating token cache directory: %w", err)
+	}
+
+	// Write to a temporary file first so a crash never leaves a
+	// truncated cache behind.
+	tmp, err := os.CreateTemp(filepath.Dir(f.Path), ".token-*.json")
+	if err != nil {
+		return fmt.Errorf("error writing token cache: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tmp.Name(), 0o600)
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), f.Path)
+	}
+	if err != nil {
+		return fmt.Errorf("error writing token cache: %w", err)
+	}
+
+	return nil
+}
+
+// WithTokenCache reuses a cached session in Authenticate while it is
+// unexpired and stores every new session in the cache.
+func WithTokenCache(cache TokenCache) Option {
+	return func(c *Client) error {
+		c.tokenCache = cache
+		return nil
+	}
+}
+
+// restoreSession loads a cached session for username. It reports false when
+// there is no usable session.
+func (c *Client) restoreSession(creds Credentials) bool {
+	if c.tokenCache == nil {
+		return false
+	}
+
+	session, err := c.tokenCache.Load()
+	if err != nil || session.IDToken == "" {
+		return false
+	}
+	if time.Now().Add(tokenExpiryLeeway).After(session.ExpiresAt) {
+		return false
+	}
+	if !strings.EqualFold(session.Claims.Email, creds.Username) &&
+		session.Claims.MemberUUID != creds.Username {
+		return false
+	}
+
+	c.setSession(session, creds)
+
+	return true
+}
@@ -0,0 +1,50 @@
+package otf_api
+
+import (
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	EndpointAttributeKey = attribute.Key("otf.endpoint")
+	LatencyAttributeKey  = attribute.Key("otf.latency_ms")
+)
+
+// WithTracerProvider instruments every API call with an OpenTelemetry
+// client span. Spans are named after the endpoint and carry the endpoint,
+// status code and latency as attributes.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(c *Client) error {
+		c.middlewares = append(c.middlewares, Tracing(tp))
+		return nil
+	}
+}
+
+// Tracing returns a middleware that wraps requests in OpenTelemetry spans
+// using otelhttp.
+func Tracing(tp trace.TracerProvider) Middleware {
+	return func(rt http.RoundTripper) http.RoundTripper {
+		annotated := internalRoundTripper(func(req *http.Request) (*http.Response, error) {
+			span := trace.SpanFromContext(req.Context())
+			span.SetAttributes(EndpointAttributeKey.String(endpointName(req)))
+
+			start := time.Now()
+			res, err := rt.RoundTrip(req)
+			span.SetAttributes(LatencyAttributeKey.Int64(time.Since(start).Milliseconds()))
+
+			return res, err
+		})
+
+		return otelhttp.NewTransport(
+			annotated,
+			otelhttp.WithTracerProvider(tp),
+			otelhttp.WithSpanNameFormatter(func(_ string, req *http.Request) string {
+				return endpointName(req)
+			}),
+		)
+	}
+}
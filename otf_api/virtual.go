@@ -0,0 +1,31 @@
+package otf_api
+
+import (
+	"context"
+)
+
+// WithVirtual only returns OTF Live classes.
+func WithVirtual() ScheduleOption {
+	return func(q *scheduleQuery) {
+		q.params.Set(VirtualQueryParamKey, "true")
+		q.virtualOnly = true
+	}
+}
+
+// GetVirtualClasses returns the scheduled OTF Live classes. They are not
+// tied to a studio, so they can be taken while traveling.
+func (c *Client) GetVirtualClasses(
+	ctx context.Context,
+	opts ...ScheduleOption,
+) (StudioScheduleResponse, error) {
+	return c.GetStudiosSchedules(ctx, nil, append(opts[:len(opts):len(opts)], WithVirtual())...)
+}
+
+// BookVirtualClass books an OTF Live class. The returned booking's
+// Class.JoinURL is the link to join the stream.
+func (c *Client) BookVirtualClass(
+	ctx context.Context,
+	classID string,
+) (*Booking, error) {
+	return c.BookClass(ctx, CreateBookingRequest{ClassUUID: classID})
+}
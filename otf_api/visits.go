@@ -0,0 +1,45 @@
+package otf_api
+
+import (
+	"context"
+	"net/http"
+	"sort"
+)
+
+type StudioVisitCount struct {
+	Studio      Studio `json:"studio"`
+	ClassCount  int    `json:"visitCount"`
+	FirstVisit  Date   `json:"firstVisitDate"`
+	LatestVisit Date   `json:"lastVisitDate"`
+}
+
+type StudioVisitCountsResponse struct {
+	Data []StudioVisitCount `json:"data"`
+}
+
+// GetStudioVisitCounts returns how many classes the member has taken at
+// each studio, most visited first.
+func (c *Client) GetStudioVisitCounts(ctx context.Context) ([]StudioVisitCount, error) {
+	u, err := c.memberURL("studios-visited")
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedResp := StudioVisitCountsResponse{}
+	err = c.do(req, &parsedResp)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := parsedResp.Data
+	sort.SliceStable(counts, func(i, j int) bool {
+		return counts[i].ClassCount > counts[j].ClassCount
+	})
+
+	return counts, nil
+}
@@ -0,0 +1,53 @@
+package otf_api
+
+import (
+	"context"
+	"fmt"
+)
+
+// WaitlistResult is the outcome of joining a waitlist.
+type WaitlistResult struct {
+	Booking *Booking
+	// Position is the 1-based waitlist position, or zero when the
+	// server booked the class directly because a spot was open.
+	Position int
+}
+
+// Booked reports whether the member got a spot instead of a waitlist
+// position.
+func (wr WaitlistResult) Booked() bool {
+	return wr.Booking != nil && wr.Booking.Status == BookingStatusBooked
+}
+
+// JoinWaitlist joins the waitlist of a class.
+func (c *Client) JoinWaitlist(ctx context.Context, classID string) (WaitlistResult, error) {
+	booking, err := c.BookClass(ctx, CreateBookingRequest{
+		ClassUUID: classID,
+		Waitlist:  true,
+	})
+	if err != nil {
+		return WaitlistResult{}, fmt.Errorf("error joining waitlist: %w", err)
+	}
+
+	return WaitlistResult{
+		Booking:  booking,
+		Position: booking.WaitlistPosition,
+	}, nil
+}
+
+// LeaveWaitlist leaves a class's waitlist. It returns ErrNotWaitlisted
+// for bookings that are not on a waitlist, so a confirmed spot is never
+// given up by mistake.
+func (c *Client) LeaveWaitlist(ctx context.Context, bookingID string) error {
+	booking, err := c.GetBooking(ctx, bookingID)
+	if err != nil {
+		return err
+	}
+
+	if booking.Status != BookingStatusWaitlisted {
+		return ErrNotWaitlisted
+	}
+
+	_, err = c.CancelBooking(ctx, bookingID)
+	return err
+}
@@ -0,0 +1,118 @@
+package otf_api
+
+import (
+	"context"
+	"time"
+)
+
+// WaitlistEventType describes what changed about a waitlisted booking.
+type WaitlistEventType string
+
+const (
+	// WaitlistPositionChanged fires when the waitlist position changes.
+	WaitlistPositionChanged WaitlistEventType = "position_changed"
+	// WaitlistPromoted fires when the booking is confirmed.
+	WaitlistPromoted WaitlistEventType = "promoted"
+	// WaitlistLeft fires when the booking leaves the waitlist without
+	// being confirmed, e.g. because it was canceled.
+	WaitlistLeft WaitlistEventType = "left"
+	// WaitlistNotWaitlisted fires when the booking was never seen on the
+	// waitlist, e.g. because it was already confirmed when watching began.
+	WaitlistNotWaitlisted WaitlistEventType = "not_waitlisted"
+	// WaitlistWatchError reports a failed poll; watching continues.
+	WaitlistWatchError WaitlistEventType = "error"
+)
+
+// WaitlistEvent is delivered by WatchWaitlist.
+type WaitlistEvent struct {
+	Type     WaitlistEventType
+	Booking  Booking
+	Position int
+	// PreviousPosition is zero on the first event.
+	PreviousPosition int
+	At               time.Time
+	Err              error
+}
+
+// WatchWaitlist polls a waitlisted booking every interval and reports
+// position changes and promotion, so an auto-promoted class never comes as
+// a surprise. Non-positive intervals default to DefaultWatchInterval.
+// Promotions are also delivered to OnBookingEvent handlers. A promotion
+// is only reported once a poll has seen the booking waitlisted; a booking
+// that is not on the waitlist yields a single WaitlistNotWaitlisted event.
+// The channel is closed after the booking is promoted or leaves the
+// waitlist, or once ctx is done.
+func (c *Client) WatchWaitlist(
+	ctx context.Context,
+	bookingID string,
+	interval time.Duration,
+) <-chan WaitlistEvent {
+	interval = watchInterval(interval)
+	events := make(chan WaitlistEvent)
+
+	go func() {
+		defer close(events)
+
+		send := func(ev WaitlistEvent) bool {
+			select {
+			case events <- ev:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		position := 0
+		waitlisted := false
+		for {
+			booking, err := c.GetBooking(ctx, bookingID)
+			switch {
+			case err != nil:
+				if ctx.Err() != nil {
+					return
+				}
+				if !send(WaitlistEvent{Type: WaitlistWatchError, At: time.Now(), Err: err}) {
+					return
+				}
+			case booking.Status == BookingStatusWaitlisted:
+				if booking.WaitlistPosition > 0 {
+					waitlisted = true
+				}
+				if booking.WaitlistPosition != position {
+					ev := WaitlistEvent{
+						Type:             WaitlistPositionChanged,
+						Booking:          booking,
+						Position:         booking.WaitlistPosition,
+						PreviousPosition: position,
+						At:               time.Now(),
+					}
+					position = booking.WaitlistPosition
+					if !send(ev) {
+						return
+					}
+				}
+			case !waitlisted:
+				send(WaitlistEvent{Type: WaitlistNotWaitlisted, Booking: booking, At: time.Now()})
+				return
+			case booking.Status == BookingStatusBooked:
+				c.emit(BookingEvent{Type: BookingEventWaitlistPromoted, Booking: booking})
+				send(WaitlistEvent{Type: WaitlistPromoted, Booking: booking, PreviousPosition: position, At: time.Now()})
+				return
+			default:
+				send(WaitlistEvent{Type: WaitlistLeft, Booking: booking, PreviousPosition: position, At: time.Now()})
+				return
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return events
+}
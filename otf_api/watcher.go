@@ -0,0 +1,205 @@
+package otf_api
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// WatchEventType describes what changed about a watched class.
+type WatchEventType string
+
+const (
+	// EventSpotOpened fires when a full class gets an open spot, and on
+	// the first poll for classes that already have one.
+	EventSpotOpened WatchEventType = "spot_opened"
+	// EventClassCanceled fires when a class is canceled.
+	EventClassCanceled WatchEventType = "class_canceled"
+	// EventWaitlistShrunk fires when the waitlist of a class gets shorter.
+	EventWaitlistShrunk WatchEventType = "waitlist_shrunk"
+	// EventWatchError reports a failed poll; watching continues.
+	EventWatchError WatchEventType = "error"
+)
+
+// WatchEvent is delivered by a ScheduleWatcher.
+type WatchEvent struct {
+	Type     WatchEventType
+	ClassID  string
+	Current  ClassAvailability
+	Previous ClassAvailability
+	At       time.Time
+	Err      error
+}
+
+// DefaultWatchInterval is the polling interval used when a watcher is
+// given a non-positive one.
+const DefaultWatchInterval = time.Minute
+
+// watchInterval returns interval, or DefaultWatchInterval when it is not
+// positive.
+func watchInterval(interval time.Duration) time.Duration {
+	if interval <= 0 {
+		return DefaultWatchInterval
+	}
+
+	return interval
+}
+
+// ScheduleWatcher polls class availability and reports changes.
+type ScheduleWatcher struct {
+	client   *Client
+	interval time.Duration
+
+	classIDs  []string
+	studioIDs []string
+	filter    func(StudioClass) bool
+	opts      []ScheduleOption
+}
+
+// NewScheduleWatcher watches the given classes, polling each one's
+// availability every interval. Non-positive intervals default to
+// DefaultWatchInterval.
+func (c *Client) NewScheduleWatcher(interval time.Duration, classIDs ...string) *ScheduleWatcher {
+	return &ScheduleWatcher{
+		client:   c,
+		interval: watchInterval(interval),
+		classIDs: classIDs,
+	}
+}
+
+// NewScheduleFilterWatcher watches every class at the studios accepted by
+// filter, polling the studios' schedules every interval. Non-positive
+// intervals default to DefaultWatchInterval.
+func (c *Client) NewScheduleFilterWatcher(
+	interval time.Duration,
+	studioIDs []string,
+	filter func(StudioClass) bool,
+	opts ...ScheduleOption,
+) *ScheduleWatcher {
+	return &ScheduleWatcher{
+		client:    c,
+		interval:  watchInterval(interval),
+		studioIDs: studioIDs,
+		filter:    filter,
+		opts:      opts,
+	}
+}
+
+// Watch starts polling and returns the event channel. The channel is
+// closed once ctx is done.
+func (w *ScheduleWatcher) Watch(ctx context.Context) <-chan WatchEvent {
+	events := make(chan WatchEvent)
+
+	go func() {
+		defer close(events)
+
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		var previous map[string]ClassAvailability
+		for {
+			current, err := w.poll(ctx)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				if !w.send(ctx, events, WatchEvent{Type: EventWatchError, At: time.Now(), Err: err}) {
+					return
+				}
+			} else {
+				for _, ev := range diffAvailability(previous, current) {
+					if !w.send(ctx, events, ev) {
+						return
+					}
+				}
+				previous = current
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return events
+}
+
+func (w *ScheduleWatcher) send(ctx context.Context, events chan<- WatchEvent, ev WatchEvent) bool {
+	select {
+	case events <- ev:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// poll returns the current availability of every watched class.
+func (w *ScheduleWatcher) poll(ctx context.Context) (map[string]ClassAvailability, error) {
+	current := make(map[string]ClassAvailability)
+
+	if w.filter != nil {
+		schedule, err := w.client.GetStudiosSchedules(ctx, w.studioIDs, w.opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, sc := range schedule.Items {
+			if w.filter(sc) {
+				current[sc.ID] = sc.Availability()
+			}
+		}
+
+		return current, nil
+	}
+
+	for _, id := range w.classIDs {
+		availability, err := w.client.GetClassAvailability(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("error polling class %s: %w", id, err)
+		}
+		current[id] = availability
+	}
+
+	return current, nil
+}
+
+// diffAvailability returns the events between two polls. A nil previous
+// poll only reports classes that already have open spots.
+func diffAvailability(previous, current map[string]ClassAvailability) []WatchEvent {
+	var events []WatchEvent
+
+	ids := make([]string, 0, len(current))
+	for id := range current {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		cur := current[id]
+		prev, seen := previous[id]
+		ev := WatchEvent{ClassID: id, Current: cur, Previous: prev, At: cur.FetchedAt}
+
+		switch {
+		case cur.Canceled && seen && !prev.Canceled:
+			ev.Type = EventClassCanceled
+			events = append(events, ev)
+			continue
+		case cur.Canceled:
+			continue
+		}
+
+		if cur.SpotsLeft > 0 && (!seen || prev.SpotsLeft == 0) {
+			ev.Type = EventSpotOpened
+			events = append(events, ev)
+		}
+		if seen && cur.WaitlistSize < prev.WaitlistSize {
+			ev.Type = EventWaitlistShrunk
+			events = append(events, ev)
+		}
+	}
+
+	return events
+}